@@ -17,7 +17,7 @@ provider "databricks-ovh" {
   databricks_password    = var.databricks_password
 }
 
-resource "databricks_ovh_workspace" "example" {
+resource "databricks-ovh_workspace" "example" {
   name                = "example-workspace"
   region              = "eu-west-1"
   tier                = "PREMIUM"
@@ -31,15 +31,13 @@ resource "databricks_ovh_workspace" "example" {
   }
 }
 
-resource "databricks_ovh_cluster" "example" {
-  workspace_id     = databricks_ovh_workspace.example.workspace_id
-  cluster_name     = "example-cluster"
-  spark_version    = "11.3.x-scala2.12"
-  node_type_id     = "i3.xlarge"
+resource "databricks-ovh_cluster" "example" {
+  workspace_id        = databricks-ovh_workspace.example.workspace_id
+  cluster_name        = "example-cluster"
+  spark_version       = "11.3.x-scala2.12"
+  node_type_id        = "i3.xlarge"
   driver_node_type_id = "i3.xlarge"
   
-  num_workers = 2
-  
   autoscale {
     min_workers = 1
     max_workers = 4
@@ -48,8 +46,7 @@ resource "databricks_ovh_cluster" "example" {
   autotermination_minutes = 60
   
   spark_conf = {
-    "spark.databricks.cluster.profile" = "singleNode"
-    "spark.master"                      = "local[*]"
+    "spark.sql.shuffle.partitions" = "64"
   }
   
   custom_tags = {
@@ -58,8 +55,8 @@ resource "databricks_ovh_cluster" "example" {
   }
 }
 
-resource "databricks_ovh_job" "example" {
-  workspace_id = databricks_ovh_workspace.example.workspace_id
+resource "databricks-ovh_job" "example" {
+  workspace_id = databricks-ovh_workspace.example.workspace_id
   name         = "example-job"
   
   new_cluster {
@@ -83,9 +80,9 @@ resource "databricks_ovh_job" "example" {
 }
 
 output "workspace_url" {
-  value = databricks_ovh_workspace.example.workspace_url
+  value = databricks-ovh_workspace.example.workspace_url
 }
 
 output "cluster_id" {
-  value = databricks_ovh_cluster.example.cluster_id
+  value = databricks-ovh_cluster.example.cluster_id
 }
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Credential statuses reported by the API. Only validated credentials can be
+// used.
+const (
+	CredentialStatusValidated         = "validated"
+	CredentialStatusPendingValidation = "pendingValidation"
+	CredentialStatusExpired           = "expired"
+	CredentialStatusRefused           = "refused"
+)
+
+// Credential is the consumer key the requests are signed with, as returned by
+// /auth/currentCredential.
+type Credential struct {
+	CredentialID  FlexString   `json:"credentialId"`
+	ApplicationID FlexString   `json:"applicationId"`
+	Status        string       `json:"status"`
+	Expiration    *time.Time   `json:"expiration"`
+	Rules         []AccessRule `json:"rules"`
+}
+
+// AccessRule grants an HTTP method on the paths matching Path, in which *
+// matches any sequence of characters.
+type AccessRule struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+}
+
+// CurrentCredential returns the credential of the consumer key the client
+// is configured with.
+func (c *Client) CurrentCredential(ctx context.Context) (*Credential, error) {
+	var credential Credential
+	if err := c.get(ctx, "/auth/currentCredential", &credential); err != nil {
+		return nil, err
+	}
+	return &credential, nil
+}
+
+// Allows reports whether a rule of the credential grants method on every path
+// matching pattern, which uses * like the rules.
+func (c *Credential) Allows(method, pattern string) bool {
+	for _, rule := range c.Rules {
+		if !strings.EqualFold(rule.Method, method) {
+			continue
+		}
+		// A rule covers the pattern when it matches the pattern itself, its
+		// wildcards included.
+		expr := "^" + strings.ReplaceAll(regexp.QuoteMeta(rule.Path), `\*`, ".*") + "$"
+		if matched, _ := regexp.MatchString(expr, pattern); matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+// Package client implements a typed client for the OVH Databricks API.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// ErrMissingID is returned when the API accepts a create request but does not
+// return an identifier for the new object.
+var ErrMissingID = errors.New("response does not contain an identifier")
+
+// ErrNotFound is returned when an object that is looked up in a listing, rather
+// than fetched by path, is not part of it.
+var ErrNotFound = errors.New("object not found")
+
+// DefaultPollInterval is the delay between two status checks while waiting
+// for an object to reach a target state.
+const DefaultPollInterval = 10 * time.Second
+
+// Client wraps an OVH API client and exposes one method per Databricks endpoint.
+type Client struct {
+	ovh *ovh.Client
+
+	// PollInterval is the delay between two status checks in the Wait methods.
+	PollInterval time.Duration
+}
+
+// New returns a Client that sends its requests through the given OVH client.
+func New(ovhClient *ovh.Client) *Client {
+	return &Client{ovh: ovhClient, PollInterval: DefaultPollInterval}
+}
+
+// ProjectClient is a Client bound to a single OVH Public Cloud project.
+type ProjectClient struct {
+	*Client
+	serviceName string
+}
+
+// Project returns a client for the Databricks objects of the Public Cloud
+// project with the given service name.
+func (c *Client) Project(serviceName string) *ProjectClient {
+	return &ProjectClient{Client: c, serviceName: serviceName}
+}
+
+// ServiceName returns the service name of the project.
+func (c *ProjectClient) ServiceName() string {
+	return c.serviceName
+}
+
+// Error describes a failed API call. The underlying error is usually an
+// *ovh.APIError, or a *databricks.APIError for calls to a workspace, and can
+// be retrieved with errors.As.
+type Error struct {
+	Method string
+	Path   string
+	Err    error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s %s: %s", e.Method, e.Path, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// IsNotFound reports whether err is an API error with status 404 or
+// ErrNotFound.
+func IsNotFound(err error) bool {
+	if errors.Is(err, ErrNotFound) {
+		return true
+	}
+	var apiErr *ovh.APIError
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound
+}
+
+// FlexString is a string that also accepts JSON numbers. The API is not
+// consistent about the type it uses for identifiers and timestamps.
+type FlexString string
+
+func (s *FlexString) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	if bytes.Equal(b, []byte("null")) {
+		*s = ""
+		return nil
+	}
+
+	if len(b) > 0 && b[0] == '"' {
+		var v string
+		if err := json.Unmarshal(b, &v); err != nil {
+			return err
+		}
+		*s = FlexString(v)
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return fmt.Errorf("cannot decode %s as a string or number", b)
+	}
+	*s = FlexString(n.String())
+	return nil
+}
+
+func (s FlexString) String() string {
+	return string(s)
+}
+
+// collectionPath returns the path of the objects of the given kind.
+func (c *ProjectClient) collectionPath(kind string) string {
+	return "/cloud/project/" + url.PathEscape(c.serviceName) + "/databricks/" + kind
+}
+
+// objectPath returns the path of a single object of the given kind.
+func (c *ProjectClient) objectPath(kind, id string) string {
+	return c.collectionPath(kind) + "/" + url.PathEscape(id)
+}
+
+func (c *Client) get(ctx context.Context, path string, out interface{}) error {
+	return c.call(ctx, http.MethodGet, path, nil, out)
+}
+
+func (c *Client) post(ctx context.Context, path string, in, out interface{}) error {
+	return c.call(ctx, http.MethodPost, path, in, out)
+}
+
+func (c *Client) put(ctx context.Context, path string, in, out interface{}) error {
+	return c.call(ctx, http.MethodPut, path, in, out)
+}
+
+func (c *Client) delete(ctx context.Context, path string) error {
+	return c.call(ctx, http.MethodDelete, path, nil, nil)
+}
+
+func (c *Client) call(ctx context.Context, method, path string, in, out interface{}) error {
+	if err := c.ovh.CallAPIWithContext(ctx, method, path, in, out, true); err != nil {
+		return &Error{Method: method, Path: path, Err: err}
+	}
+	return nil
+}
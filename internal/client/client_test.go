@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func TestFlexStringUnmarshal(t *testing.T) {
+	tests := map[string]string{
+		`{"id":"abc-123"}`:         "abc-123",
+		`{"id":123456789012}`:      "123456789012",
+		`{"id":1.5}`:               "1.5",
+		`{"id":null}`:              "",
+		`{"other":"field"}`:        "",
+		`{"id":"with \"quotes\""}`: `with "quotes"`,
+	}
+
+	for in, want := range tests {
+		var out struct {
+			ID FlexString `json:"id"`
+		}
+		if err := json.Unmarshal([]byte(in), &out); err != nil {
+			t.Fatalf("unmarshal %s: %s", in, err)
+		}
+		if out.ID.String() != want {
+			t.Errorf("unmarshal %s: got %q, want %q", in, out.ID, want)
+		}
+	}
+
+	var out struct {
+		ID FlexString `json:"id"`
+	}
+	if err := json.Unmarshal([]byte(`{"id":true}`), &out); err == nil {
+		t.Errorf("expected an error when decoding a boolean")
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	notFound := &Error{Method: "GET", Path: "/x", Err: &ovh.APIError{Code: 404}}
+	if !IsNotFound(notFound) {
+		t.Errorf("expected a wrapped 404 to be reported as not found")
+	}
+
+	forbidden := &Error{Method: "GET", Path: "/x", Err: &ovh.APIError{Code: 403}}
+	if IsNotFound(forbidden) {
+		t.Errorf("expected a 403 not to be reported as not found")
+	}
+
+	if IsNotFound(errors.New("connection refused")) {
+		t.Errorf("expected a transport error not to be reported as not found")
+	}
+}
+
+// newTestClient returns a Client talking to an httptest server that serves
+// /auth/time itself and hands every other request to handler.
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/auth/time", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, time.Now().Unix())
+	})
+	mux.Handle("/", handler)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	ovhClient, err := ovh.NewClient(server.URL, "app-key", "app-secret", "consumer-key")
+	if err != nil {
+		t.Fatalf("creating OVH client: %s", err)
+	}
+
+	c := New(ovhClient)
+	c.PollInterval = time.Millisecond
+	return c
+}
+
+func TestGetSecret(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if want := "/cloud/project/project-1/databricks/workspace/ws-1/secret-scope/etl%2Fprod/secret"; r.URL.EscapedPath() != want {
+			t.Errorf("got path %s, want %s", r.URL.EscapedPath(), want)
+		}
+		json.NewEncoder(w).Encode([]SecretMetadata{
+			{Key: "db-password", LastUpdatedTimestamp: 1700000000000},
+		})
+	}))
+	api := c.Project("project-1").Workspace("ws-1")
+
+	secret, err := api.GetSecret(context.Background(), "etl/prod", "db-password")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if secret.LastUpdatedTimestamp != 1700000000000 {
+		t.Errorf("got timestamp %d", secret.LastUpdatedTimestamp)
+	}
+
+	if _, err := api.GetSecret(context.Background(), "etl/prod", "missing"); !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestCallHonoursContext(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := c.Project("project-1").GetWorkspace(ctx, "ws-1")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected a cancellation error, got %v", err)
+	}
+}
+
+func TestCredentialAllows(t *testing.T) {
+	const pattern = "/cloud/project/p1/databricks/*"
+
+	tests := map[string]struct {
+		rule AccessRule
+		want bool
+	}{
+		"everything":         {rule: AccessRule{Method: "GET", Path: "/*"}, want: true},
+		"all projects":       {rule: AccessRule{Method: "GET", Path: "/cloud/project/*/databricks/*"}, want: true},
+		"same pattern":       {rule: AccessRule{Method: "get", Path: pattern}, want: true},
+		"other method":       {rule: AccessRule{Method: "POST", Path: "/*"}, want: false},
+		"other project":      {rule: AccessRule{Method: "GET", Path: "/cloud/project/p2/*"}, want: false},
+		"single object only": {rule: AccessRule{Method: "GET", Path: "/cloud/project/p1/databricks/workspace"}, want: false},
+	}
+
+	for name, tt := range tests {
+		credential := &Credential{Rules: []AccessRule{tt.rule}}
+		if got := credential.Allows("GET", pattern); got != tt.want {
+			t.Errorf("%s: got %t, want %t", name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package client
+
+import "context"
+
+// Types of the rules of a cluster policy definition.
+const (
+	PolicyRuleFixed     = "fixed"
+	PolicyRuleForbidden = "forbidden"
+	PolicyRuleAllowlist = "allowlist"
+	PolicyRuleBlocklist = "blocklist"
+	PolicyRuleRange     = "range"
+	PolicyRuleRegex     = "regex"
+	PolicyRuleUnlimited = "unlimited"
+)
+
+// PolicyRule is a rule of a cluster policy definition, which maps cluster
+// attribute paths such as spark_version or custom_tags.team to rules. Values
+// are strings, numbers or booleans depending on the attribute.
+type PolicyRule struct {
+	Type         string   `json:"type"`
+	Value        any      `json:"value,omitempty"`
+	Values       []any    `json:"values,omitempty"`
+	Pattern      string   `json:"pattern,omitempty"`
+	MinValue     *float64 `json:"minValue,omitempty"`
+	MaxValue     *float64 `json:"maxValue,omitempty"`
+	DefaultValue any      `json:"defaultValue,omitempty"`
+	IsOptional   bool     `json:"isOptional,omitempty"`
+	Hidden       bool     `json:"hidden,omitempty"`
+}
+
+// ClusterPolicy is a Databricks cluster policy as returned by the API. The
+// definition of a policy created from a policy family is the definition of
+// the family with the overrides applied.
+type ClusterPolicy struct {
+	ID                              FlexString `json:"id"`
+	WorkspaceID                     string     `json:"workspaceId"`
+	Name                            string     `json:"name"`
+	Definition                      string     `json:"definition"`
+	Description                     string     `json:"description"`
+	MaxClustersPerUser              *int64     `json:"maxClustersPerUser"`
+	PolicyFamilyID                  string     `json:"policyFamilyId"`
+	PolicyFamilyDefinitionOverrides string     `json:"policyFamilyDefinitionOverrides"`
+	Libraries                       []Library  `json:"libraries"`
+	PolicyID                        FlexString `json:"policyId"`
+	CreatedTime                     FlexString `json:"createdTime"`
+}
+
+// ClusterPolicyCreateRequest is the body of a cluster policy creation call.
+// Policies created from a policy family have no definition.
+type ClusterPolicyCreateRequest struct {
+	WorkspaceID                     string    `json:"workspaceId"`
+	Name                            string    `json:"name"`
+	Definition                      string    `json:"definition,omitempty"`
+	Description                     string    `json:"description,omitempty"`
+	MaxClustersPerUser              *int64    `json:"maxClustersPerUser,omitempty"`
+	PolicyFamilyID                  string    `json:"policyFamilyId,omitempty"`
+	PolicyFamilyDefinitionOverrides string    `json:"policyFamilyDefinitionOverrides,omitempty"`
+	Libraries                       []Library `json:"libraries,omitempty"`
+}
+
+// ClusterPolicyUpdateRequest is the body of a cluster policy update call. The
+// policy family of a policy cannot be changed.
+type ClusterPolicyUpdateRequest struct {
+	Name                            string    `json:"name"`
+	Definition                      string    `json:"definition,omitempty"`
+	Description                     string    `json:"description"`
+	MaxClustersPerUser              *int64    `json:"maxClustersPerUser"`
+	PolicyFamilyDefinitionOverrides string    `json:"policyFamilyDefinitionOverrides,omitempty"`
+	Libraries                       []Library `json:"libraries"`
+}
+
+// PolicyFamily is a built-in template of cluster policies, such as Personal
+// Compute or Job Compute.
+type PolicyFamily struct {
+	PolicyFamilyID string `json:"policyFamilyId"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	Definition     string `json:"definition"`
+}
+
+// CreateClusterPolicy creates a cluster policy.
+func (c *ProjectClient) CreateClusterPolicy(ctx context.Context, req *ClusterPolicyCreateRequest) (*ClusterPolicy, error) {
+	var policy ClusterPolicy
+	if err := c.post(ctx, c.collectionPath("cluster-policy"), req, &policy); err != nil {
+		return nil, err
+	}
+	if policy.ID == "" {
+		return nil, ErrMissingID
+	}
+	return &policy, nil
+}
+
+// GetClusterPolicy returns the cluster policy with the given identifier.
+func (c *ProjectClient) GetClusterPolicy(ctx context.Context, id string) (*ClusterPolicy, error) {
+	var policy ClusterPolicy
+	if err := c.get(ctx, c.objectPath("cluster-policy", id), &policy); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// ListClusterPolicies returns all cluster policies.
+func (c *ProjectClient) ListClusterPolicies(ctx context.Context) ([]ClusterPolicy, error) {
+	var policies []ClusterPolicy
+	if err := c.get(ctx, c.collectionPath("cluster-policy"), &policies); err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// FindClusterPolicy returns the cluster policy with the given Databricks
+// policy ID, which clusters and jobs refer to.
+func (c *ProjectClient) FindClusterPolicy(ctx context.Context, policyID string) (*ClusterPolicy, error) {
+	policies, err := c.ListClusterPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range policies {
+		if policies[i].PolicyID.String() == policyID {
+			return &policies[i], nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// UpdateClusterPolicy updates the cluster policy with the given identifier.
+func (c *ProjectClient) UpdateClusterPolicy(ctx context.Context, id string, req *ClusterPolicyUpdateRequest) error {
+	return c.put(ctx, c.objectPath("cluster-policy", id), req, nil)
+}
+
+// ListPolicyFamilies returns the policy families cluster policies can be
+// created from.
+func (c *ProjectClient) ListPolicyFamilies(ctx context.Context) ([]PolicyFamily, error) {
+	var families []PolicyFamily
+	if err := c.get(ctx, c.collectionPath("policy-family"), &families); err != nil {
+		return nil, err
+	}
+	return families, nil
+}
+
+// DeleteClusterPolicy deletes the cluster policy with the given identifier.
+func (c *ProjectClient) DeleteClusterPolicy(ctx context.Context, id string) error {
+	return c.delete(ctx, c.objectPath("cluster-policy", id))
+}
@@ -0,0 +1,97 @@
+package client
+
+import "context"
+
+// Cluster states reported by the API.
+const (
+	ClusterStatePending     = "PENDING"
+	ClusterStateRunning     = "RUNNING"
+	ClusterStateRestarting  = "RESTARTING"
+	ClusterStateResizing    = "RESIZING"
+	ClusterStateTerminating = "TERMINATING"
+	ClusterStateTerminated  = "TERMINATED"
+	ClusterStateError       = "ERROR"
+)
+
+// Cluster is a Databricks all-purpose cluster as returned by the API.
+type Cluster struct {
+	ClusterSettings
+
+	ID           FlexString `json:"id"`
+	WorkspaceID  string     `json:"workspaceId"`
+	ClusterID    FlexString `json:"clusterId"`
+	State        string     `json:"state"`
+	StateMessage string     `json:"stateMessage"`
+	CreatedTime  FlexString `json:"createdTime"`
+}
+
+// ClusterSettings holds the user-defined part of a cluster.
+type ClusterSettings struct {
+	ClusterName            string            `json:"clusterName,omitempty"`
+	SparkVersion           string            `json:"sparkVersion"`
+	NodeTypeID             string            `json:"nodeTypeId"`
+	DriverNodeTypeID       string            `json:"driverNodeTypeId,omitempty"`
+	NumWorkers             *int64            `json:"numWorkers,omitempty"`
+	Autoscale              *AutoScale        `json:"autoscale,omitempty"`
+	AutoterminationMinutes *int64            `json:"autoterminationMinutes,omitempty"`
+	SparkConf              map[string]string `json:"sparkConf,omitempty"`
+	CustomTags             map[string]string `json:"customTags,omitempty"`
+	PolicyID               string            `json:"policyId,omitempty"`
+}
+
+// ClusterCreateRequest is the body of a cluster creation call.
+type ClusterCreateRequest struct {
+	ClusterSettings
+
+	WorkspaceID string `json:"workspaceId"`
+}
+
+// ClusterUpdateRequest is the body of a cluster update call. It replaces all
+// the settings of the cluster; a running cluster only picks up some of them
+// after a restart.
+type ClusterUpdateRequest struct {
+	ClusterSettings
+}
+
+// ClusterResizeRequest is the body of a cluster resize call. Exactly one of
+// the fields is set.
+type ClusterResizeRequest struct {
+	NumWorkers *int64     `json:"numWorkers,omitempty"`
+	Autoscale  *AutoScale `json:"autoscale,omitempty"`
+}
+
+// CreateCluster creates and starts a cluster.
+func (c *ProjectClient) CreateCluster(ctx context.Context, req *ClusterCreateRequest) (*Cluster, error) {
+	var cluster Cluster
+	if err := c.post(ctx, c.collectionPath("cluster"), req, &cluster); err != nil {
+		return nil, err
+	}
+	if cluster.ID == "" {
+		return nil, ErrMissingID
+	}
+	return &cluster, nil
+}
+
+// GetCluster returns the cluster with the given identifier.
+func (c *ProjectClient) GetCluster(ctx context.Context, id string) (*Cluster, error) {
+	var cluster Cluster
+	if err := c.get(ctx, c.objectPath("cluster", id), &cluster); err != nil {
+		return nil, err
+	}
+	return &cluster, nil
+}
+
+// UpdateCluster updates the cluster with the given identifier.
+func (c *ProjectClient) UpdateCluster(ctx context.Context, id string, req *ClusterUpdateRequest) error {
+	return c.put(ctx, c.objectPath("cluster", id), req, nil)
+}
+
+// ResizeCluster changes the size of a running cluster without restarting it.
+func (c *ProjectClient) ResizeCluster(ctx context.Context, id string, req *ClusterResizeRequest) error {
+	return c.post(ctx, c.objectPath("cluster", id)+"/resize", req, nil)
+}
+
+// DeleteCluster terminates the cluster with the given identifier.
+func (c *ProjectClient) DeleteCluster(ctx context.Context, id string) error {
+	return c.delete(ctx, c.objectPath("cluster", id))
+}
@@ -0,0 +1,111 @@
+package client
+
+import "context"
+
+// Billing periods of the instances of a pool. Monthly billing is cheaper for
+// instances that are kept idle for most of the month.
+const (
+	InstancePoolBillingHourly  = "HOURLY"
+	InstancePoolBillingMonthly = "MONTHLY"
+)
+
+// InstancePool is a Databricks instance pool as returned by the API.
+type InstancePool struct {
+	ID                                 FlexString                 `json:"id"`
+	WorkspaceID                        string                     `json:"workspaceId"`
+	Name                               string                     `json:"name"`
+	NodeTypeID                         string                     `json:"nodeTypeId"`
+	MinIdleInstances                   *int64                     `json:"minIdleInstances"`
+	MaxCapacity                        *int64                     `json:"maxCapacity"`
+	IdleInstanceAutoterminationMinutes *int64                     `json:"idleInstanceAutoterminationMinutes"`
+	EnableElasticDisk                  *bool                      `json:"enableElasticDisk"`
+	DiskSpec                           *DiskSpec                  `json:"diskSpec"`
+	PreloadedSparkVersions             []string                   `json:"preloadedSparkVersions"`
+	OVHAttributes                      *InstancePoolOVHAttributes `json:"ovhAttributes"`
+	CustomTags                         map[string]string          `json:"customTags"`
+	DefaultTags                        map[string]string          `json:"defaultTags"`
+	Stats                              *InstancePoolStats         `json:"stats"`
+	PoolID                             FlexString                 `json:"poolId"`
+	Status                             string                     `json:"status"`
+	CreatedTime                        FlexString                 `json:"createdTime"`
+}
+
+// DiskSpec describes the disks attached to each instance of a pool.
+type DiskSpec struct {
+	DiskType  string `json:"diskType,omitempty"`
+	DiskCount int64  `json:"diskCount,omitempty"`
+	DiskSize  int64  `json:"diskSize,omitempty"`
+}
+
+// InstancePoolOVHAttributes holds the OVH settings of the instances of a
+// pool.
+type InstancePoolOVHAttributes struct {
+	BillingPeriod    string `json:"billingPeriod,omitempty"`
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+}
+
+// InstancePoolStats counts the instances of a pool by state.
+type InstancePoolStats struct {
+	UsedCount        int64 `json:"usedCount"`
+	IdleCount        int64 `json:"idleCount"`
+	PendingUsedCount int64 `json:"pendingUsedCount"`
+	PendingIdleCount int64 `json:"pendingIdleCount"`
+}
+
+// InstancePoolCreateRequest is the body of an instance pool creation call.
+type InstancePoolCreateRequest struct {
+	WorkspaceID                        string                     `json:"workspaceId"`
+	Name                               string                     `json:"name"`
+	NodeTypeID                         string                     `json:"nodeTypeId"`
+	MinIdleInstances                   int64                      `json:"minIdleInstances"`
+	MaxCapacity                        int64                      `json:"maxCapacity"`
+	IdleInstanceAutoterminationMinutes *int64                     `json:"idleInstanceAutoterminationMinutes,omitempty"`
+	EnableElasticDisk                  *bool                      `json:"enableElasticDisk,omitempty"`
+	DiskSpec                           *DiskSpec                  `json:"diskSpec,omitempty"`
+	PreloadedSparkVersions             []string                   `json:"preloadedSparkVersions,omitempty"`
+	OVHAttributes                      *InstancePoolOVHAttributes `json:"ovhAttributes,omitempty"`
+	CustomTags                         map[string]string          `json:"customTags,omitempty"`
+}
+
+// InstancePoolUpdateRequest is the body of an instance pool update call. The
+// disks, preloaded Spark versions and OVH attributes of a pool cannot be
+// changed.
+type InstancePoolUpdateRequest struct {
+	Name                               string            `json:"name"`
+	NodeTypeID                         string            `json:"nodeTypeId"`
+	MinIdleInstances                   int64             `json:"minIdleInstances"`
+	MaxCapacity                        int64             `json:"maxCapacity"`
+	IdleInstanceAutoterminationMinutes *int64            `json:"idleInstanceAutoterminationMinutes,omitempty"`
+	CustomTags                         map[string]string `json:"customTags"`
+}
+
+// CreateInstancePool creates an instance pool.
+func (c *ProjectClient) CreateInstancePool(ctx context.Context, req *InstancePoolCreateRequest) (*InstancePool, error) {
+	var pool InstancePool
+	if err := c.post(ctx, c.collectionPath("instance-pool"), req, &pool); err != nil {
+		return nil, err
+	}
+	if pool.ID == "" {
+		return nil, ErrMissingID
+	}
+	return &pool, nil
+}
+
+// GetInstancePool returns the instance pool with the given identifier.
+func (c *ProjectClient) GetInstancePool(ctx context.Context, id string) (*InstancePool, error) {
+	var pool InstancePool
+	if err := c.get(ctx, c.objectPath("instance-pool", id), &pool); err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
+// UpdateInstancePool updates the instance pool with the given identifier.
+func (c *ProjectClient) UpdateInstancePool(ctx context.Context, id string, req *InstancePoolUpdateRequest) error {
+	return c.put(ctx, c.objectPath("instance-pool", id), req, nil)
+}
+
+// DeleteInstancePool deletes the instance pool with the given identifier.
+func (c *ProjectClient) DeleteInstancePool(ctx context.Context, id string) error {
+	return c.delete(ctx, c.objectPath("instance-pool", id))
+}
@@ -0,0 +1,80 @@
+package client
+
+import "context"
+
+// Life-cycle states of a job run.
+const (
+	RunLifeCycleStatePending       = "PENDING"
+	RunLifeCycleStateRunning       = "RUNNING"
+	RunLifeCycleStateTerminating   = "TERMINATING"
+	RunLifeCycleStateTerminated    = "TERMINATED"
+	RunLifeCycleStateSkipped       = "SKIPPED"
+	RunLifeCycleStateInternalError = "INTERNAL_ERROR"
+)
+
+// Result states of a terminated job run.
+const (
+	RunResultStateSuccess  = "SUCCESS"
+	RunResultStateFailed   = "FAILED"
+	RunResultStateTimedOut = "TIMEDOUT"
+	RunResultStateCanceled = "CANCELED"
+)
+
+// JobRun is a run of a Databricks job as returned by the API.
+type JobRun struct {
+	RunID      FlexString `json:"runId"`
+	JobID      FlexString `json:"jobId"`
+	State      RunState   `json:"state"`
+	RunPageURL string     `json:"runPageUrl"`
+}
+
+// RunState is the state of a job run. ResultState is only set once the run
+// has terminated.
+type RunState struct {
+	LifeCycleState string `json:"lifeCycleState"`
+	ResultState    string `json:"resultState"`
+	StateMessage   string `json:"stateMessage"`
+}
+
+// Terminal reports whether the run has reached a final life-cycle state.
+func (s RunState) Terminal() bool {
+	switch s.LifeCycleState {
+	case RunLifeCycleStateTerminated, RunLifeCycleStateSkipped, RunLifeCycleStateInternalError:
+		return true
+	default:
+		return false
+	}
+}
+
+// JobRunNowRequest is the body of a run-now call. The parameters override
+// those of the job settings for this run only.
+type JobRunNowRequest struct {
+	NotebookParams map[string]string `json:"notebookParams,omitempty"`
+	PythonParams   []string          `json:"pythonParams,omitempty"`
+}
+
+// RunJobNow triggers a run of the job with the given identifier.
+func (c *ProjectClient) RunJobNow(ctx context.Context, jobID string, req *JobRunNowRequest) (*JobRun, error) {
+	var run JobRun
+	if err := c.post(ctx, c.objectPath("job", jobID)+"/run-now", req, &run); err != nil {
+		return nil, err
+	}
+	if run.RunID == "" {
+		return nil, ErrMissingID
+	}
+	return &run, nil
+}
+
+// GetJobRun returns the job run with the given identifier.
+func (c *ProjectClient) GetJobRun(ctx context.Context, runID string) (*JobRun, error) {
+	var run JobRun
+	if err := c.get(ctx, c.objectPath("job-run", runID), &run); err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+// CancelJobRun cancels the job run with the given identifier.
+func (c *ProjectClient) CancelJobRun(ctx context.Context, runID string) error {
+	return c.post(ctx, c.objectPath("job-run", runID)+"/cancel", nil, nil)
+}
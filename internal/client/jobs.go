@@ -0,0 +1,177 @@
+package client
+
+import "context"
+
+// Job is a Databricks job as returned by the API.
+type Job struct {
+	JobSettings
+
+	ID              FlexString `json:"id"`
+	WorkspaceID     string     `json:"workspaceId"`
+	JobID           FlexString `json:"jobId"`
+	Status          string     `json:"status"`
+	CreatorUserName string     `json:"creatorUserName"`
+	CreatedTime     FlexString `json:"createdTime"`
+}
+
+// JobSettings holds the user-defined part of a job.
+type JobSettings struct {
+	Name                   string              `json:"name"`
+	NewCluster             *ClusterSpec        `json:"newCluster,omitempty"`
+	ExistingClusterID      string              `json:"existingClusterId,omitempty"`
+	NotebookTask           *NotebookTask       `json:"notebookTask,omitempty"`
+	SparkJarTask           *SparkJarTask       `json:"sparkJarTask,omitempty"`
+	SparkPythonTask        *SparkPythonTask    `json:"sparkPythonTask,omitempty"`
+	SparkSubmitTask        *SparkSubmitTask    `json:"sparkSubmitTask,omitempty"`
+	PipelineTask           *PipelineTask       `json:"pipelineTask,omitempty"`
+	PythonWheelTask        *PythonWheelTask    `json:"pythonWheelTask,omitempty"`
+	Libraries              []Library           `json:"libraries,omitempty"`
+	EmailNotifications     *EmailNotifications `json:"emailNotifications,omitempty"`
+	TimeoutSeconds         *int64              `json:"timeoutSeconds,omitempty"`
+	MaxRetries             *int64              `json:"maxRetries,omitempty"`
+	MinRetryIntervalMillis *int64              `json:"minRetryIntervalMillis,omitempty"`
+	RetryOnTimeout         *bool               `json:"retryOnTimeout,omitempty"`
+	Schedule               *CronSchedule       `json:"schedule,omitempty"`
+	MaxConcurrentRuns      *int64              `json:"maxConcurrentRuns,omitempty"`
+	Tags                   map[string]string   `json:"tags,omitempty"`
+	Tasks                  []JobTask           `json:"tasks,omitempty"`
+	JobClusters            []JobCluster        `json:"jobClusters,omitempty"`
+}
+
+// Run conditions of a task, evaluated against the outcome of the tasks it
+// depends on.
+const (
+	RunIfAllSuccess        = "ALL_SUCCESS"
+	RunIfAtLeastOneSuccess = "AT_LEAST_ONE_SUCCESS"
+	RunIfNoneFailed        = "NONE_FAILED"
+	RunIfAllDone           = "ALL_DONE"
+	RunIfAtLeastOneFailed  = "AT_LEAST_ONE_FAILED"
+	RunIfAllFailed         = "ALL_FAILED"
+)
+
+// JobTask is one task of a multi-task job.
+type JobTask struct {
+	TaskKey                string           `json:"taskKey"`
+	Description            string           `json:"description,omitempty"`
+	DependsOn              []TaskDependency `json:"dependsOn,omitempty"`
+	RunIf                  string           `json:"runIf,omitempty"`
+	JobClusterKey          string           `json:"jobClusterKey,omitempty"`
+	ExistingClusterID      string           `json:"existingClusterId,omitempty"`
+	NewCluster             *ClusterSpec     `json:"newCluster,omitempty"`
+	NotebookTask           *NotebookTask    `json:"notebookTask,omitempty"`
+	SparkJarTask           *SparkJarTask    `json:"sparkJarTask,omitempty"`
+	SparkPythonTask        *SparkPythonTask `json:"sparkPythonTask,omitempty"`
+	SparkSubmitTask        *SparkSubmitTask `json:"sparkSubmitTask,omitempty"`
+	PipelineTask           *PipelineTask    `json:"pipelineTask,omitempty"`
+	PythonWheelTask        *PythonWheelTask `json:"pythonWheelTask,omitempty"`
+	Libraries              []Library        `json:"libraries,omitempty"`
+	TimeoutSeconds         *int64           `json:"timeoutSeconds,omitempty"`
+	MaxRetries             *int64           `json:"maxRetries,omitempty"`
+	MinRetryIntervalMillis *int64           `json:"minRetryIntervalMillis,omitempty"`
+	RetryOnTimeout         *bool            `json:"retryOnTimeout,omitempty"`
+}
+
+// TaskDependency references a task that must complete before another runs.
+type TaskDependency struct {
+	TaskKey string `json:"taskKey"`
+}
+
+// JobCluster is a cluster specification shared by the tasks of a job.
+type JobCluster struct {
+	JobClusterKey string      `json:"jobClusterKey"`
+	NewCluster    ClusterSpec `json:"newCluster"`
+}
+
+// NotebookTask runs a notebook.
+type NotebookTask struct {
+	NotebookPath   string            `json:"notebookPath"`
+	BaseParameters map[string]string `json:"baseParameters,omitempty"`
+}
+
+// SparkJarTask runs the main class of a JAR.
+type SparkJarTask struct {
+	MainClassName string   `json:"mainClassName"`
+	Parameters    []string `json:"parameters,omitempty"`
+}
+
+// SparkPythonTask runs a Python file.
+type SparkPythonTask struct {
+	PythonFile string   `json:"pythonFile"`
+	Parameters []string `json:"parameters,omitempty"`
+}
+
+// SparkSubmitTask runs spark-submit with the given parameters.
+type SparkSubmitTask struct {
+	Parameters []string `json:"parameters"`
+}
+
+// PipelineTask triggers a Delta Live Tables pipeline.
+type PipelineTask struct {
+	PipelineID string `json:"pipelineId"`
+}
+
+// PythonWheelTask runs an entry point of a Python wheel.
+type PythonWheelTask struct {
+	PackageName string   `json:"packageName"`
+	EntryPoint  string   `json:"entryPoint"`
+	Parameters  []string `json:"parameters,omitempty"`
+}
+
+// EmailNotifications lists the addresses notified on run events.
+type EmailNotifications struct {
+	OnStart               []string `json:"onStart,omitempty"`
+	OnSuccess             []string `json:"onSuccess,omitempty"`
+	OnFailure             []string `json:"onFailure,omitempty"`
+	NoAlertForSkippedRuns *bool    `json:"noAlertForSkippedRuns,omitempty"`
+}
+
+// CronSchedule triggers a job periodically.
+type CronSchedule struct {
+	QuartzCronExpression string `json:"quartzCronExpression"`
+	TimezoneID           string `json:"timezoneId"`
+	PauseStatus          string `json:"pauseStatus,omitempty"`
+}
+
+// JobCreateRequest is the body of a job creation call.
+type JobCreateRequest struct {
+	JobSettings
+
+	WorkspaceID string `json:"workspaceId"`
+}
+
+// JobUpdateRequest is the body of a job update call. It replaces all the
+// settings of the job.
+type JobUpdateRequest struct {
+	JobSettings
+}
+
+// CreateJob creates a job.
+func (c *ProjectClient) CreateJob(ctx context.Context, req *JobCreateRequest) (*Job, error) {
+	var job Job
+	if err := c.post(ctx, c.collectionPath("job"), req, &job); err != nil {
+		return nil, err
+	}
+	if job.ID == "" {
+		return nil, ErrMissingID
+	}
+	return &job, nil
+}
+
+// GetJob returns the job with the given identifier.
+func (c *ProjectClient) GetJob(ctx context.Context, id string) (*Job, error) {
+	var job Job
+	if err := c.get(ctx, c.objectPath("job", id), &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// UpdateJob updates the job with the given identifier.
+func (c *ProjectClient) UpdateJob(ctx context.Context, id string, req *JobUpdateRequest) error {
+	return c.put(ctx, c.objectPath("job", id), req, nil)
+}
+
+// DeleteJob deletes the job with the given identifier.
+func (c *ProjectClient) DeleteJob(ctx context.Context, id string) error {
+	return c.delete(ctx, c.objectPath("job", id))
+}
@@ -0,0 +1,63 @@
+package client
+
+import "context"
+
+// Notebook is a Databricks notebook as returned by the API.
+type Notebook struct {
+	ID          FlexString `json:"id"`
+	WorkspaceID string     `json:"workspaceId"`
+	Path        string     `json:"path"`
+	Language    string     `json:"language"`
+	Content     string     `json:"content"`
+	Format      string     `json:"format"`
+	NotebookID  FlexString `json:"notebookId"`
+	CreatedTime FlexString `json:"createdTime"`
+}
+
+// NotebookCreateRequest is the body of a notebook creation call.
+type NotebookCreateRequest struct {
+	WorkspaceID string `json:"workspaceId"`
+	Path        string `json:"path"`
+	Language    string `json:"language"`
+	Content     string `json:"content"`
+	Format      string `json:"format"`
+}
+
+// NotebookUpdateRequest is the body of a notebook update call.
+type NotebookUpdateRequest struct {
+	Path     string `json:"path"`
+	Language string `json:"language"`
+	Content  string `json:"content"`
+	Format   string `json:"format"`
+}
+
+// CreateNotebook creates a notebook.
+func (c *ProjectClient) CreateNotebook(ctx context.Context, req *NotebookCreateRequest) (*Notebook, error) {
+	var notebook Notebook
+	if err := c.post(ctx, c.collectionPath("notebook"), req, &notebook); err != nil {
+		return nil, err
+	}
+	if notebook.ID == "" {
+		return nil, ErrMissingID
+	}
+	return &notebook, nil
+}
+
+// GetNotebook returns the notebook with the given identifier.
+func (c *ProjectClient) GetNotebook(ctx context.Context, id string) (*Notebook, error) {
+	var notebook Notebook
+	if err := c.get(ctx, c.objectPath("notebook", id), &notebook); err != nil {
+		return nil, err
+	}
+	return &notebook, nil
+}
+
+// UpdateNotebook updates the notebook with the given identifier.
+func (c *ProjectClient) UpdateNotebook(ctx context.Context, id string, req *NotebookUpdateRequest) error {
+	return c.put(ctx, c.objectPath("notebook", id), req, nil)
+}
+
+// DeleteNotebook deletes the notebook with the given identifier.
+func (c *ProjectClient) DeleteNotebook(ctx context.Context, id string) error {
+	return c.delete(ctx, c.objectPath("notebook", id))
+}
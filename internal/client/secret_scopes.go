@@ -0,0 +1,54 @@
+package client
+
+import "context"
+
+// SecretScope is a Databricks secret scope as returned by the API.
+type SecretScope struct {
+	ID          FlexString `json:"id"`
+	WorkspaceID string     `json:"workspaceId"`
+	Name        string     `json:"name"`
+	ScopeID     FlexString `json:"scopeId"`
+	CreatedTime FlexString `json:"createdTime"`
+}
+
+// SecretScopeCreateRequest is the body of a secret scope creation call.
+type SecretScopeCreateRequest struct {
+	WorkspaceID string `json:"workspaceId"`
+	Name        string `json:"name"`
+}
+
+// SecretScopeUpdateRequest is the body of a secret scope update call.
+type SecretScopeUpdateRequest struct {
+	Name string `json:"name"`
+}
+
+// CreateSecretScope creates a secret scope.
+func (c *ProjectClient) CreateSecretScope(ctx context.Context, req *SecretScopeCreateRequest) (*SecretScope, error) {
+	var scope SecretScope
+	if err := c.post(ctx, c.collectionPath("secret-scope"), req, &scope); err != nil {
+		return nil, err
+	}
+	if scope.ID == "" {
+		return nil, ErrMissingID
+	}
+	return &scope, nil
+}
+
+// GetSecretScope returns the secret scope with the given identifier.
+func (c *ProjectClient) GetSecretScope(ctx context.Context, id string) (*SecretScope, error) {
+	var scope SecretScope
+	if err := c.get(ctx, c.objectPath("secret-scope", id), &scope); err != nil {
+		return nil, err
+	}
+	return &scope, nil
+}
+
+// UpdateSecretScope updates the secret scope with the given identifier.
+func (c *ProjectClient) UpdateSecretScope(ctx context.Context, id string, req *SecretScopeUpdateRequest) error {
+	return c.put(ctx, c.objectPath("secret-scope", id), req, nil)
+}
+
+// DeleteSecretScope deletes the secret scope with the given identifier.
+func (c *ProjectClient) DeleteSecretScope(ctx context.Context, id string) error {
+	return c.delete(ctx, c.objectPath("secret-scope", id))
+}
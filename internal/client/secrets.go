@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"net/url"
+)
+
+// WorkspaceClient is a client for the objects the OVH API nests under a
+// workspace, such as secrets.
+type WorkspaceClient struct {
+	*ProjectClient
+
+	workspaceID string
+}
+
+// Workspace returns a client for the objects of the given workspace.
+func (c *ProjectClient) Workspace(workspaceID string) *WorkspaceClient {
+	return &WorkspaceClient{ProjectClient: c, workspaceID: workspaceID}
+}
+
+// workspacePath returns the path of an object nested under the workspace.
+// Each element is escaped.
+func (c *WorkspaceClient) workspacePath(elem ...string) string {
+	p := c.objectPath("workspace", c.workspaceID)
+	for _, e := range elem {
+		p += "/" + url.PathEscape(e)
+	}
+	return p
+}
+
+// SecretMetadata describes a secret of a scope. The API never returns secret
+// values.
+type SecretMetadata struct {
+	Key                  string `json:"key"`
+	LastUpdatedTimestamp int64  `json:"lastUpdatedTimestamp"`
+}
+
+// SecretPutRequest is the body of a secret creation or overwrite call.
+type SecretPutRequest struct {
+	Key         string `json:"key"`
+	StringValue string `json:"stringValue"`
+}
+
+// PutSecret creates the secret, or overwrites its value if it exists, in the
+// scope with the given name.
+func (c *WorkspaceClient) PutSecret(ctx context.Context, scope string, req *SecretPutRequest) error {
+	return c.post(ctx, c.workspacePath("secret-scope", scope, "secret"), req, nil)
+}
+
+// ListSecrets returns the metadata of the secrets of the scope with the given
+// name.
+func (c *WorkspaceClient) ListSecrets(ctx context.Context, scope string) ([]SecretMetadata, error) {
+	var secrets []SecretMetadata
+	if err := c.get(ctx, c.workspacePath("secret-scope", scope, "secret"), &secrets); err != nil {
+		return nil, err
+	}
+	return secrets, nil
+}
+
+// GetSecret returns the metadata of a secret. Secrets cannot be read one by
+// one, so it lists the scope and fails with ErrNotFound when the key is not
+// part of it.
+func (c *WorkspaceClient) GetSecret(ctx context.Context, scope, key string) (*SecretMetadata, error) {
+	secrets, err := c.ListSecrets(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	for i := range secrets {
+		if secrets[i].Key == key {
+			return &secrets[i], nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// DeleteSecret deletes a secret from the scope with the given name.
+func (c *WorkspaceClient) DeleteSecret(ctx context.Context, scope, key string) error {
+	return c.delete(ctx, c.workspacePath("secret-scope", scope, "secret", key))
+}
+
+// Permissions that can be granted on a secret scope.
+const (
+	SecretACLPermissionRead   = "READ"
+	SecretACLPermissionWrite  = "WRITE"
+	SecretACLPermissionManage = "MANAGE"
+)
+
+// SecretACL grants a permission on a secret scope to a user or group.
+type SecretACL struct {
+	Principal  string `json:"principal"`
+	Permission string `json:"permission"`
+}
+
+// PutSecretACL creates or replaces the ACL of a principal on the scope with
+// the given name.
+func (c *WorkspaceClient) PutSecretACL(ctx context.Context, scope string, acl *SecretACL) error {
+	return c.post(ctx, c.workspacePath("secret-scope", scope, "acl"), acl, nil)
+}
+
+// GetSecretACL returns the ACL of a principal on the scope with the given
+// name.
+func (c *WorkspaceClient) GetSecretACL(ctx context.Context, scope, principal string) (*SecretACL, error) {
+	var acl SecretACL
+	if err := c.get(ctx, c.workspacePath("secret-scope", scope, "acl", principal), &acl); err != nil {
+		return nil, err
+	}
+	return &acl, nil
+}
+
+// DeleteSecretACL removes the ACL of a principal from the scope with the
+// given name.
+func (c *WorkspaceClient) DeleteSecretACL(ctx context.Context, scope, principal string) error {
+	return c.delete(ctx, c.workspacePath("secret-scope", scope, "acl", principal))
+}
@@ -0,0 +1,45 @@
+package client
+
+// ClusterSpec describes the cluster a job or task runs on.
+type ClusterSpec struct {
+	SparkVersion string     `json:"sparkVersion"`
+	NodeTypeID   string     `json:"nodeTypeId"`
+	NumWorkers   *int64     `json:"numWorkers,omitempty"`
+	Autoscale    *AutoScale `json:"autoscale,omitempty"`
+	PolicyID     string     `json:"policyId,omitempty"`
+}
+
+// AutoScale bounds the number of workers of an autoscaling cluster.
+type AutoScale struct {
+	MinWorkers int64 `json:"minWorkers"`
+	MaxWorkers int64 `json:"maxWorkers"`
+}
+
+// Library is a library installed on a cluster. Exactly one field is set.
+type Library struct {
+	Jar   string        `json:"jar,omitempty"`
+	Egg   string        `json:"egg,omitempty"`
+	Whl   string        `json:"whl,omitempty"`
+	PyPI  *PythonPyPI   `json:"pypi,omitempty"`
+	Maven *MavenLibrary `json:"maven,omitempty"`
+	CRAN  *RCRAN        `json:"cran,omitempty"`
+}
+
+// PythonPyPI is a library installed from a PyPI repository.
+type PythonPyPI struct {
+	Package string `json:"package"`
+	Repo    string `json:"repo,omitempty"`
+}
+
+// MavenLibrary is a library resolved from a Maven repository.
+type MavenLibrary struct {
+	Coordinates string   `json:"coordinates"`
+	Repo        string   `json:"repo,omitempty"`
+	Exclusions  []string `json:"exclusions,omitempty"`
+}
+
+// RCRAN is a library installed from a CRAN repository.
+type RCRAN struct {
+	Package string `json:"package"`
+	Repo    string `json:"repo,omitempty"`
+}
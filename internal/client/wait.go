@@ -0,0 +1,212 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// StatusError is returned by the Wait methods when an object reaches a
+// failed terminal state.
+type StatusError struct {
+	Kind    string
+	ID      string
+	Status  string
+	Message string
+}
+
+func (e *StatusError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("%s %s reached status %s", e.Kind, e.ID, e.Status)
+	}
+	return fmt.Sprintf("%s %s reached status %s: %s", e.Kind, e.ID, e.Status, e.Message)
+}
+
+// poll calls check every interval until it reports done, returns an error or
+// ctx is done. The first check happens immediately.
+func poll(ctx context.Context, interval time.Duration, check func() (bool, error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitForWorkspaceRunning polls the workspace until it is RUNNING and returns
+// its last representation. It fails with a *StatusError when the workspace
+// reaches FAILED, and with the context error when ctx expires first.
+func (c *ProjectClient) WaitForWorkspaceRunning(ctx context.Context, id string) (*Workspace, error) {
+	var workspace *Workspace
+
+	err := poll(ctx, c.PollInterval, func() (bool, error) {
+		var err error
+		workspace, err = c.GetWorkspace(ctx, id)
+		if err != nil {
+			return false, err
+		}
+
+		switch workspace.WorkspaceStatus {
+		case WorkspaceStatusRunning:
+			return true, nil
+		case WorkspaceStatusFailed:
+			return false, &StatusError{
+				Kind:    "workspace",
+				ID:      id,
+				Status:  workspace.WorkspaceStatus,
+				Message: workspace.StatusMessage,
+			}
+		default:
+			return false, nil
+		}
+	})
+	if err != nil {
+		if workspace != nil && ctx.Err() != nil {
+			return workspace, fmt.Errorf("workspace %s is still %s: %w", id, workspace.WorkspaceStatus, err)
+		}
+		return workspace, err
+	}
+
+	return workspace, nil
+}
+
+// WaitForWorkspaceDeleted polls the workspace until the API no longer knows it.
+func (c *ProjectClient) WaitForWorkspaceDeleted(ctx context.Context, id string) error {
+	return poll(ctx, c.PollInterval, func() (bool, error) {
+		workspace, err := c.GetWorkspace(ctx, id)
+		if IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if workspace.WorkspaceStatus == WorkspaceStatusFailed {
+			return false, &StatusError{
+				Kind:    "workspace",
+				ID:      id,
+				Status:  workspace.WorkspaceStatus,
+				Message: workspace.StatusMessage,
+			}
+		}
+		return false, nil
+	})
+}
+
+// ClusterGetter is implemented by the clients that can read a cluster.
+type ClusterGetter interface {
+	GetCluster(ctx context.Context, id string) (*Cluster, error)
+}
+
+// WaitForClusterRunning polls the cluster until it is RUNNING and returns its
+// last representation. It fails with a *StatusError when the cluster reaches
+// ERROR or terminates, and with the context error when ctx expires first.
+func (c *ProjectClient) WaitForClusterRunning(ctx context.Context, id string) (*Cluster, error) {
+	return WaitClusterRunning(ctx, c, c.PollInterval, id)
+}
+
+// WaitForClusterTerminated polls the cluster until it is TERMINATED or the
+// API no longer knows it.
+func (c *ProjectClient) WaitForClusterTerminated(ctx context.Context, id string) error {
+	return WaitClusterTerminated(ctx, c, c.PollInterval, id)
+}
+
+// WaitClusterRunning implements WaitForClusterRunning for any client that can
+// read a cluster.
+func WaitClusterRunning(ctx context.Context, api ClusterGetter, interval time.Duration, id string) (*Cluster, error) {
+	var cluster *Cluster
+
+	err := poll(ctx, interval, func() (bool, error) {
+		var err error
+		cluster, err = api.GetCluster(ctx, id)
+		if err != nil {
+			return false, err
+		}
+
+		switch cluster.State {
+		case ClusterStateRunning:
+			return true, nil
+		case ClusterStateError, ClusterStateTerminating, ClusterStateTerminated:
+			return false, &StatusError{
+				Kind:    "cluster",
+				ID:      id,
+				Status:  cluster.State,
+				Message: cluster.StateMessage,
+			}
+		default:
+			return false, nil
+		}
+	})
+	if err != nil {
+		if cluster != nil && ctx.Err() != nil {
+			return cluster, fmt.Errorf("cluster %s is still %s: %w", id, cluster.State, err)
+		}
+		return cluster, err
+	}
+
+	return cluster, nil
+}
+
+// WaitClusterTerminated implements WaitForClusterTerminated for any client
+// that can read a cluster.
+func WaitClusterTerminated(ctx context.Context, api ClusterGetter, interval time.Duration, id string) error {
+	return poll(ctx, interval, func() (bool, error) {
+		cluster, err := api.GetCluster(ctx, id)
+		if IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return cluster.State == ClusterStateTerminated, nil
+	})
+}
+
+// WaitForJobRunTerminated polls the job run until it reaches a terminal
+// life-cycle state and returns its last representation. It fails with a
+// *StatusError when the run does not end with SUCCESS, and with the context
+// error when ctx expires first.
+func (c *ProjectClient) WaitForJobRunTerminated(ctx context.Context, runID string) (*JobRun, error) {
+	var run *JobRun
+
+	err := poll(ctx, c.PollInterval, func() (bool, error) {
+		var err error
+		run, err = c.GetJobRun(ctx, runID)
+		if err != nil {
+			return false, err
+		}
+		if !run.State.Terminal() {
+			return false, nil
+		}
+
+		if run.State.ResultState != RunResultStateSuccess {
+			status := run.State.ResultState
+			if status == "" {
+				status = run.State.LifeCycleState
+			}
+			return false, &StatusError{
+				Kind:    "job run",
+				ID:      runID,
+				Status:  status,
+				Message: run.State.StateMessage,
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		if run != nil && ctx.Err() != nil {
+			return run, fmt.Errorf("job run %s is still %s: %w", runID, run.State.LifeCycleState, err)
+		}
+		return run, err
+	}
+
+	return run, nil
+}
@@ -0,0 +1,159 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testWorkspacePath = "/cloud/project/project-1/databricks/workspace/ws-1"
+
+// workspaceStatusHandler serves the test workspace, reporting each of the
+// given statuses once and then repeating the last one.
+func workspaceStatusHandler(t *testing.T, calls *int32, statuses ...string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != testWorkspacePath {
+			t.Errorf("unexpected request path %s", r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+
+		n := int(atomic.AddInt32(calls, 1)) - 1
+		if n >= len(statuses) {
+			n = len(statuses) - 1
+		}
+
+		json.NewEncoder(w).Encode(Workspace{
+			ID:              "ws-1",
+			WorkspaceStatus: statuses[n],
+			StatusMessage:   "quota exceeded in region",
+			WorkspaceURL:    "https://ws-1.databricks.example",
+		})
+	})
+}
+
+func TestWaitForWorkspaceRunning(t *testing.T) {
+	var calls int32
+	c := newTestClient(t, workspaceStatusHandler(t, &calls, "PROVISIONING", "PROVISIONING", "RUNNING"))
+
+	workspace, err := c.Project("project-1").WaitForWorkspaceRunning(context.Background(), "ws-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if workspace.WorkspaceStatus != WorkspaceStatusRunning {
+		t.Errorf("got status %s, want RUNNING", workspace.WorkspaceStatus)
+	}
+	if workspace.WorkspaceURL == "" {
+		t.Errorf("expected the last representation of the workspace to be returned")
+	}
+	if calls != 3 {
+		t.Errorf("got %d status checks, want 3", calls)
+	}
+}
+
+func TestWaitForWorkspaceRunningFailed(t *testing.T) {
+	var calls int32
+	c := newTestClient(t, workspaceStatusHandler(t, &calls, "PROVISIONING", "FAILED"))
+
+	_, err := c.Project("project-1").WaitForWorkspaceRunning(context.Background(), "ws-1")
+
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("expected a *StatusError, got %v", err)
+	}
+	if statusErr.Message != "quota exceeded in region" {
+		t.Errorf("got message %q, want the last status message", statusErr.Message)
+	}
+}
+
+func TestWaitForWorkspaceRunningTimeout(t *testing.T) {
+	var calls int32
+	c := newTestClient(t, workspaceStatusHandler(t, &calls, "PROVISIONING"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := c.Project("project-1").WaitForWorkspaceRunning(ctx, "ws-1")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a deadline error, got %v", err)
+	}
+}
+
+func TestWaitForWorkspaceDeleted(t *testing.T) {
+	var calls int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			json.NewEncoder(w).Encode(Workspace{ID: "ws-1", WorkspaceStatus: "DELETING"})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{"message": "This service does not exist"})
+	}))
+
+	if err := c.Project("project-1").WaitForWorkspaceDeleted(context.Background(), "ws-1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 3 {
+		t.Errorf("got %d status checks, want 3", calls)
+	}
+}
+
+func TestWaitForClusterRunningTerminated(t *testing.T) {
+	var calls int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state := ClusterStatePending
+		if atomic.AddInt32(&calls, 1) > 1 {
+			state = ClusterStateTerminated
+		}
+		json.NewEncoder(w).Encode(Cluster{
+			ID:           "cl-1",
+			State:        state,
+			StateMessage: "instances unavailable",
+		})
+	}))
+
+	_, err := c.Project("project-1").WaitForClusterRunning(context.Background(), "cl-1")
+
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("expected a *StatusError, got %v", err)
+	}
+	if statusErr.Status != ClusterStateTerminated || statusErr.Message != "instances unavailable" {
+		t.Errorf("unexpected status error: %s", statusErr)
+	}
+}
+
+func TestWaitForJobRunTerminatedFailed(t *testing.T) {
+	var calls int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state := RunState{LifeCycleState: RunLifeCycleStateRunning}
+		if atomic.AddInt32(&calls, 1) > 2 {
+			state = RunState{
+				LifeCycleState: RunLifeCycleStateTerminated,
+				ResultState:    RunResultStateFailed,
+				StateMessage:   "Task migrate failed",
+			}
+		}
+		json.NewEncoder(w).Encode(JobRun{RunID: "42", State: state})
+	}))
+
+	run, err := c.Project("project-1").WaitForJobRunTerminated(context.Background(), "42")
+
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("expected a *StatusError, got %v", err)
+	}
+	if statusErr.Status != RunResultStateFailed {
+		t.Errorf("got status %s, want FAILED", statusErr.Status)
+	}
+	if run == nil || run.State.LifeCycleState != RunLifeCycleStateTerminated {
+		t.Errorf("expected the terminated run to be returned, got %+v", run)
+	}
+	if calls != 3 {
+		t.Errorf("got %d status checks, want 3", calls)
+	}
+}
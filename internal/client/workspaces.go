@@ -0,0 +1,100 @@
+package client
+
+import "context"
+
+// Workspace statuses reported by the API. Any other status is transitional.
+const (
+	WorkspaceStatusRunning = "RUNNING"
+	WorkspaceStatusFailed  = "FAILED"
+)
+
+// Workspace is a Databricks workspace as returned by the API.
+type Workspace struct {
+	ID                     FlexString        `json:"id"`
+	Name                   string            `json:"name"`
+	Region                 string            `json:"region"`
+	Tier                   string            `json:"tier"`
+	DeploymentName         string            `json:"deploymentName"`
+	AWSRegion              string            `json:"awsRegion"`
+	CredentialsID          string            `json:"credentialsId"`
+	StorageConfigurationID string            `json:"storageConfigurationId"`
+	NetworkID              string            `json:"networkId"`
+	CustomerManagedKeyID   string            `json:"customerManagedKeyId"`
+	PricingTier            string            `json:"pricingTier"`
+	CustomTags             map[string]string `json:"customTags"`
+	OVHOptimization        *bool             `json:"ovhOptimization"`
+	CostTracking           *bool             `json:"costTracking"`
+	WorkspaceID            FlexString        `json:"workspaceId"`
+	WorkspaceURL           string            `json:"workspaceUrl"`
+	WorkspaceStatus        string            `json:"workspaceStatus"`
+	StatusMessage          string            `json:"statusMessage"`
+	Status                 string            `json:"status"`
+	CreationTime           FlexString        `json:"creationTime"`
+	CreatedTime            FlexString        `json:"createdTime"`
+}
+
+// WorkspaceCreateRequest is the body of a workspace creation call.
+type WorkspaceCreateRequest struct {
+	Name                   string            `json:"name"`
+	Region                 string            `json:"region"`
+	Tier                   string            `json:"tier,omitempty"`
+	DeploymentName         string            `json:"deploymentName,omitempty"`
+	AWSRegion              string            `json:"awsRegion,omitempty"`
+	CredentialsID          string            `json:"credentialsId,omitempty"`
+	StorageConfigurationID string            `json:"storageConfigurationId,omitempty"`
+	NetworkID              string            `json:"networkId,omitempty"`
+	CustomerManagedKeyID   string            `json:"customerManagedKeyId,omitempty"`
+	PricingTier            string            `json:"pricingTier,omitempty"`
+	CustomTags             map[string]string `json:"customTags,omitempty"`
+	OVHOptimization        bool              `json:"ovhOptimization"`
+	CostTracking           bool              `json:"costTracking"`
+}
+
+// WorkspaceUpdateRequest is the body of a workspace update call. Empty fields
+// are left unchanged, except CustomTags: an empty map removes every tag.
+type WorkspaceUpdateRequest struct {
+	Name        string            `json:"name,omitempty"`
+	Tier        string            `json:"tier,omitempty"`
+	PricingTier string            `json:"pricingTier,omitempty"`
+	CustomTags  map[string]string `json:"customTags"`
+}
+
+// CreateWorkspace creates a workspace.
+func (c *ProjectClient) CreateWorkspace(ctx context.Context, req *WorkspaceCreateRequest) (*Workspace, error) {
+	var workspace Workspace
+	if err := c.post(ctx, c.collectionPath("workspace"), req, &workspace); err != nil {
+		return nil, err
+	}
+	if workspace.ID == "" {
+		return nil, ErrMissingID
+	}
+	return &workspace, nil
+}
+
+// GetWorkspace returns the workspace with the given identifier.
+func (c *ProjectClient) GetWorkspace(ctx context.Context, id string) (*Workspace, error) {
+	var workspace Workspace
+	if err := c.get(ctx, c.objectPath("workspace", id), &workspace); err != nil {
+		return nil, err
+	}
+	return &workspace, nil
+}
+
+// ListWorkspaces returns all workspaces.
+func (c *ProjectClient) ListWorkspaces(ctx context.Context) ([]Workspace, error) {
+	var workspaces []Workspace
+	if err := c.get(ctx, c.collectionPath("workspace"), &workspaces); err != nil {
+		return nil, err
+	}
+	return workspaces, nil
+}
+
+// UpdateWorkspace updates the workspace with the given identifier.
+func (c *ProjectClient) UpdateWorkspace(ctx context.Context, id string, req *WorkspaceUpdateRequest) error {
+	return c.put(ctx, c.objectPath("workspace", id), req, nil)
+}
+
+// DeleteWorkspace deletes the workspace with the given identifier.
+func (c *ProjectClient) DeleteWorkspace(ctx context.Context, id string) error {
+	return c.delete(ctx, c.objectPath("workspace", id))
+}
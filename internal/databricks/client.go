@@ -0,0 +1,208 @@
+// Package databricks implements a client for the REST API of a Databricks
+// workspace. It is used for the workspace objects that the OVH API does not
+// proxy, and returns the same types as package client so that resources can
+// use either.
+package databricks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+)
+
+// Authentication methods, in order of precedence.
+const (
+	AuthTypePAT      = "pat"
+	AuthTypeOAuthM2M = "oauth-m2m"
+	AuthTypeBasic    = "basic"
+)
+
+// Credentials authenticate the requests sent to a workspace. When several
+// methods are set, the first of AuthTypes is used.
+type Credentials struct {
+	// Token is a personal access token.
+	Token string
+
+	// ClientID and ClientSecret are the OAuth credentials of a service
+	// principal. TokenSource, when set, is used instead of a token source of
+	// the workspace so that tokens can be shared between clients.
+	ClientID     string
+	ClientSecret string
+	TokenSource  *TokenSource
+
+	Username string
+	Password string
+}
+
+// AuthTypes returns the authentication methods the credentials are complete
+// for, in order of precedence.
+func (c Credentials) AuthTypes() []string {
+	var types []string
+	if c.Token != "" {
+		types = append(types, AuthTypePAT)
+	}
+	if c.ClientID != "" && c.ClientSecret != "" {
+		types = append(types, AuthTypeOAuthM2M)
+	}
+	if c.Username != "" && c.Password != "" {
+		types = append(types, AuthTypeBasic)
+	}
+	return types
+}
+
+// AuthType returns the authentication method used by the credentials, or ""
+// when none is complete.
+func (c Credentials) AuthType() string {
+	if types := c.AuthTypes(); len(types) > 0 {
+		return types[0]
+	}
+	return ""
+}
+
+// Valid reports whether the credentials are complete for at least one
+// authentication method.
+func (c Credentials) Valid() bool {
+	return c.AuthType() != ""
+}
+
+// Client sends requests to the REST API of a single Databricks workspace.
+type Client struct {
+	host  string
+	creds Credentials
+	http  *http.Client
+
+	// PollInterval is the delay between two status checks in the Wait methods.
+	PollInterval time.Duration
+}
+
+// New returns a Client for the workspace at host, such as
+// "https://adb-1234.5.azuredatabricks.net". The scheme defaults to https. A
+// nil httpClient uses http.DefaultClient.
+func New(host string, creds Credentials, httpClient *http.Client) *Client {
+	host = normalizeHost(host)
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	if creds.AuthType() == AuthTypeOAuthM2M && creds.TokenSource == nil {
+		creds.TokenSource = NewTokenSource(WorkspaceTokenURL(host), creds.ClientID, creds.ClientSecret, httpClient)
+	}
+	return &Client{
+		host:         host,
+		creds:        creds,
+		http:         httpClient,
+		PollInterval: client.DefaultPollInterval,
+	}
+}
+
+func normalizeHost(host string) string {
+	host = strings.TrimRight(host, "/")
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+	return host
+}
+
+// Host returns the base URL of the workspace.
+func (c *Client) Host() string {
+	return c.host
+}
+
+// APIError is an error response of the workspace API. Errors for missing
+// objects match client.ErrNotFound, so client.IsNotFound handles both APIs.
+type APIError struct {
+	StatusCode int    `json:"-"`
+	ErrorCode  string `json:"error_code"`
+	Message    string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+	if e.ErrorCode == "" {
+		return fmt.Sprintf("HTTP %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("HTTP %d %s: %s", e.StatusCode, e.ErrorCode, e.Message)
+}
+
+func (e *APIError) Unwrap() error {
+	if e.StatusCode == http.StatusNotFound || e.ErrorCode == "RESOURCE_DOES_NOT_EXIST" {
+		return client.ErrNotFound
+	}
+	return nil
+}
+
+func (c *Client) get(ctx context.Context, path string, query url.Values, out any) error {
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return c.call(ctx, http.MethodGet, path, nil, out)
+}
+
+func (c *Client) post(ctx context.Context, path string, in, out any) error {
+	return c.call(ctx, http.MethodPost, path, in, out)
+}
+
+func (c *Client) call(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return &client.Error{Method: method, Path: path, Err: err}
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.host+path, body)
+	if err != nil {
+		return &client.Error{Method: method, Path: path, Err: err}
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	switch c.creds.AuthType() {
+	case AuthTypePAT:
+		req.Header.Set("Authorization", "Bearer "+c.creds.Token)
+	case AuthTypeOAuthM2M:
+		token, err := c.creds.TokenSource.Token(ctx)
+		if err != nil {
+			return &client.Error{Method: method, Path: path, Err: err}
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	case AuthTypeBasic:
+		req.SetBasicAuth(c.creds.Username, c.creds.Password)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return &client.Error{Method: method, Path: path, Err: err}
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &client.Error{Method: method, Path: path, Err: err}
+	}
+
+	if resp.StatusCode >= 300 {
+		apiErr := &APIError{StatusCode: resp.StatusCode}
+		if json.Unmarshal(b, apiErr) != nil || apiErr.Message == "" {
+			apiErr.Message = strings.TrimSpace(string(b))
+		}
+		return &client.Error{Method: method, Path: path, Err: apiErr}
+	}
+
+	if out == nil || len(b) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		return &client.Error{Method: method, Path: path, Err: err}
+	}
+	return nil
+}
@@ -0,0 +1,298 @@
+package databricks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+)
+
+// newTestClient returns a Client talking to an httptest server that serves
+// the given handler.
+func newTestClient(t *testing.T, creds Credentials, handler http.Handler) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c := New(server.URL, creds, server.Client())
+	c.PollInterval = time.Millisecond
+	return c
+}
+
+func TestAuthentication(t *testing.T) {
+	tests := map[string]struct {
+		creds Credentials
+		check func(r *http.Request) bool
+	}{
+		"token": {
+			creds: Credentials{Token: "dapi-123", Username: "ignored", Password: "ignored"},
+			check: func(r *http.Request) bool { return r.Header.Get("Authorization") == "Bearer dapi-123" },
+		},
+		"basic": {
+			creds: Credentials{Username: "alice", Password: "s3cret"},
+			check: func(r *http.Request) bool {
+				user, pass, ok := r.BasicAuth()
+				return ok && user == "alice" && pass == "s3cret"
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := newTestClient(t, tt.creds, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if !tt.check(r) {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				json.NewEncoder(w).Encode(map[string]any{"secrets": []any{}})
+			}))
+
+			if _, err := c.ListSecrets(context.Background(), "etl"); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestNotFound(t *testing.T) {
+	c := newTestClient(t, Credentials{Token: "t"}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"error_code": "RESOURCE_DOES_NOT_EXIST",
+			"message":    "Path (/Users/a/nb) doesn't exist.",
+		})
+	}))
+
+	_, err := c.GetNotebook(context.Background(), "/Users/a/nb")
+	if !client.IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+
+	c = newTestClient(t, Credentials{Token: "t"}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{"error_code": "PERMISSION_DENIED", "message": "denied"})
+	}))
+
+	_, err = c.GetNotebook(context.Background(), "/Users/a/nb")
+	if err == nil || client.IsNotFound(err) {
+		t.Fatalf("expected a permission error, got %v", err)
+	}
+}
+
+func TestSecrets(t *testing.T) {
+	secrets := map[string]int64{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/2.0/secrets/put", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["scope"] != "etl" || body["string_value"] != "v" {
+			t.Errorf("unexpected put body: %v", body)
+		}
+		secrets[body["key"]] = 1700000000000
+	})
+	mux.HandleFunc("GET /api/2.0/secrets/list", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("scope") != "etl" {
+			t.Errorf("unexpected scope %q", r.URL.Query().Get("scope"))
+		}
+		var list []map[string]any
+		for key, ts := range secrets {
+			list = append(list, map[string]any{"key": key, "last_updated_timestamp": ts})
+		}
+		json.NewEncoder(w).Encode(map[string]any{"secrets": list})
+	})
+
+	c := newTestClient(t, Credentials{Token: "t"}, mux)
+
+	if err := c.PutSecret(context.Background(), "etl", &client.SecretPutRequest{Key: "password", StringValue: "v"}); err != nil {
+		t.Fatalf("put: %s", err)
+	}
+
+	secret, err := c.GetSecret(context.Background(), "etl", "password")
+	if err != nil {
+		t.Fatalf("get: %s", err)
+	}
+	if secret.LastUpdatedTimestamp != 1700000000000 {
+		t.Errorf("got timestamp %d", secret.LastUpdatedTimestamp)
+	}
+
+	if _, err := c.GetSecret(context.Background(), "etl", "missing"); !client.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestClusterLifecycle(t *testing.T) {
+	state := client.ClusterStatePending
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/2.0/clusters/create", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["spark_version"] != "13.3.x-scala2.12" || body["num_workers"] != float64(2) {
+			t.Errorf("unexpected create body: %v", body)
+		}
+		if _, ok := body["autoscale"]; ok {
+			t.Errorf("unexpected autoscale in create body")
+		}
+		json.NewEncoder(w).Encode(map[string]string{"cluster_id": "0101-abc"})
+	})
+	mux.HandleFunc("GET /api/2.0/clusters/get", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"cluster_id":    r.URL.Query().Get("cluster_id"),
+			"spark_version": "13.3.x-scala2.12",
+			"node_type_id":  "b2-15",
+			"num_workers":   2,
+			"state":         state,
+			"start_time":    1700000000000,
+		})
+		state = client.ClusterStateRunning
+	})
+
+	c := newTestClient(t, Credentials{Token: "t"}, mux)
+
+	numWorkers := int64(2)
+	cluster, err := c.CreateCluster(context.Background(), &client.ClusterCreateRequest{
+		ClusterSettings: client.ClusterSettings{
+			SparkVersion: "13.3.x-scala2.12",
+			NodeTypeID:   "b2-15",
+			NumWorkers:   &numWorkers,
+		},
+	})
+	if err != nil {
+		t.Fatalf("create: %s", err)
+	}
+	if cluster.ID != "0101-abc" || cluster.CreatedTime != "1700000000000" {
+		t.Errorf("unexpected cluster %+v", cluster)
+	}
+
+	cluster, err = c.WaitForClusterRunning(context.Background(), cluster.ID.String())
+	if err != nil {
+		t.Fatalf("wait: %s", err)
+	}
+	if cluster.State != client.ClusterStateRunning {
+		t.Errorf("got state %s", cluster.State)
+	}
+}
+
+func TestPermissions(t *testing.T) {
+	var acl []AccessControl
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /api/2.0/permissions/clusters/0101-abc", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			AccessControlList []AccessControl `json:"access_control_list"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		acl = body.AccessControlList
+		json.NewEncoder(w).Encode(map[string]any{})
+	})
+	mux.HandleFunc("GET /api/2.0/permissions/clusters/0101-abc", func(w http.ResponseWriter, r *http.Request) {
+		list := []map[string]any{{
+			"group_name":      "admins",
+			"all_permissions": []map[string]any{{"permission_level": "CAN_MANAGE", "inherited": true}},
+		}}
+		for _, a := range acl {
+			list = append(list, map[string]any{
+				"user_name":       a.UserName,
+				"group_name":      a.GroupName,
+				"all_permissions": []map[string]any{{"permission_level": a.PermissionLevel, "inherited": false}},
+			})
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"object_id":           "/clusters/0101-abc",
+			"object_type":         "cluster",
+			"access_control_list": list,
+		})
+	})
+
+	c := newTestClient(t, Credentials{Token: "t"}, mux)
+
+	err := c.SetPermissions(context.Background(), "clusters", "0101-abc", []AccessControl{
+		{UserName: "alice@example.com", PermissionLevel: "CAN_RESTART"},
+		{GroupName: "data", PermissionLevel: "CAN_ATTACH_TO"},
+	})
+	if err != nil {
+		t.Fatalf("set: %s", err)
+	}
+
+	perms, err := c.GetPermissions(context.Background(), "clusters", "0101-abc")
+	if err != nil {
+		t.Fatalf("get: %s", err)
+	}
+	if len(perms.AccessControlList) != 2 || perms.AccessControlList[0].UserName != "alice@example.com" ||
+		perms.AccessControlList[1].PermissionLevel != "CAN_ATTACH_TO" {
+		t.Errorf("expected the direct permissions only, got %+v", perms.AccessControlList)
+	}
+}
+
+func TestOAuthTokenCaching(t *testing.T) {
+	tests := map[string]struct {
+		expiresIn int
+		want      int
+	}{
+		"cached":          {expiresIn: 3600, want: 1},
+		"refreshed early": {expiresIn: 30, want: 2},
+		"no expiry":       {expiresIn: 0, want: 1},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tokenRequests := 0
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("POST /oidc/v1/token", func(w http.ResponseWriter, r *http.Request) {
+				id, secret, _ := r.BasicAuth()
+				if id != "sp-id" || secret != "sp-secret" || r.FormValue("grant_type") != "client_credentials" {
+					w.WriteHeader(http.StatusUnauthorized)
+					json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client", "error_description": "bad credentials"})
+					return
+				}
+				tokenRequests++
+				token := map[string]any{
+					"access_token": "oauth-token",
+					"token_type":   "Bearer",
+				}
+				if tt.expiresIn != 0 {
+					token["expires_in"] = tt.expiresIn
+				}
+				json.NewEncoder(w).Encode(token)
+			})
+			mux.HandleFunc("GET /api/2.0/secrets/list", func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != "Bearer oauth-token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				json.NewEncoder(w).Encode(map[string]any{"secrets": []any{}})
+			})
+
+			c := newTestClient(t, Credentials{ClientID: "sp-id", ClientSecret: "sp-secret", Username: "ignored", Password: "ignored"}, mux)
+
+			for range 2 {
+				if _, err := c.ListSecrets(context.Background(), "etl"); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+			if tokenRequests != tt.want {
+				t.Errorf("got %d token requests, want %d", tokenRequests, tt.want)
+			}
+		})
+	}
+}
+
+func TestOAuthTokenError(t *testing.T) {
+	c := newTestClient(t, Credentials{ClientID: "sp-id", ClientSecret: "wrong"}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client", "error_description": "Client authentication failed"})
+	}))
+
+	_, err := c.ListSecrets(context.Background(), "etl")
+	if err == nil || !strings.Contains(err.Error(), "invalid_client: Client authentication failed") {
+		t.Fatalf("expected the OAuth error, got %v", err)
+	}
+}
@@ -0,0 +1,31 @@
+package databricks
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+)
+
+type clusterPolicy struct {
+	PolicyID           string            `json:"policy_id"`
+	Name               string            `json:"name"`
+	Definition         string            `json:"definition"`
+	CreatedAtTimestamp client.FlexString `json:"created_at_timestamp"`
+}
+
+// FindClusterPolicy returns the cluster policy with the given policy ID.
+func (c *Client) FindClusterPolicy(ctx context.Context, policyID string) (*client.ClusterPolicy, error) {
+	var policy clusterPolicy
+	if err := c.get(ctx, "/api/2.0/policies/clusters/get", url.Values{"policy_id": {policyID}}, &policy); err != nil {
+		return nil, err
+	}
+
+	return &client.ClusterPolicy{
+		ID:          client.FlexString(policy.PolicyID),
+		Name:        policy.Name,
+		Definition:  policy.Definition,
+		PolicyID:    client.FlexString(policy.PolicyID),
+		CreatedTime: policy.CreatedAtTimestamp,
+	}, nil
+}
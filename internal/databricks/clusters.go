@@ -0,0 +1,141 @@
+package databricks
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+)
+
+type autoScale struct {
+	MinWorkers int64 `json:"min_workers"`
+	MaxWorkers int64 `json:"max_workers"`
+}
+
+type clusterSettings struct {
+	ClusterID              string            `json:"cluster_id,omitempty"`
+	ClusterName            string            `json:"cluster_name,omitempty"`
+	SparkVersion           string            `json:"spark_version"`
+	NodeTypeID             string            `json:"node_type_id"`
+	DriverNodeTypeID       string            `json:"driver_node_type_id,omitempty"`
+	NumWorkers             *int64            `json:"num_workers,omitempty"`
+	Autoscale              *autoScale        `json:"autoscale,omitempty"`
+	AutoterminationMinutes *int64            `json:"autotermination_minutes,omitempty"`
+	SparkConf              map[string]string `json:"spark_conf,omitempty"`
+	CustomTags             map[string]string `json:"custom_tags,omitempty"`
+	PolicyID               string            `json:"policy_id,omitempty"`
+}
+
+type clusterResize struct {
+	ClusterID  string     `json:"cluster_id"`
+	NumWorkers *int64     `json:"num_workers,omitempty"`
+	Autoscale  *autoScale `json:"autoscale,omitempty"`
+}
+
+type clusterInfo struct {
+	clusterSettings
+
+	State        string            `json:"state"`
+	StateMessage string            `json:"state_message"`
+	StartTime    client.FlexString `json:"start_time"`
+}
+
+func toAutoScale(a *client.AutoScale) *autoScale {
+	if a == nil {
+		return nil
+	}
+	return &autoScale{MinWorkers: a.MinWorkers, MaxWorkers: a.MaxWorkers}
+}
+
+func toClusterSettings(id string, s client.ClusterSettings) *clusterSettings {
+	return &clusterSettings{
+		ClusterID:              id,
+		ClusterName:            s.ClusterName,
+		SparkVersion:           s.SparkVersion,
+		NodeTypeID:             s.NodeTypeID,
+		DriverNodeTypeID:       s.DriverNodeTypeID,
+		NumWorkers:             s.NumWorkers,
+		Autoscale:              toAutoScale(s.Autoscale),
+		AutoterminationMinutes: s.AutoterminationMinutes,
+		SparkConf:              s.SparkConf,
+		CustomTags:             s.CustomTags,
+		PolicyID:               s.PolicyID,
+	}
+}
+
+// CreateCluster creates and starts a cluster. The workspace ID of the request
+// is ignored: the client is bound to a workspace.
+func (c *Client) CreateCluster(ctx context.Context, req *client.ClusterCreateRequest) (*client.Cluster, error) {
+	var resp struct {
+		ClusterID string `json:"cluster_id"`
+	}
+	if err := c.post(ctx, "/api/2.0/clusters/create", toClusterSettings("", req.ClusterSettings), &resp); err != nil {
+		return nil, err
+	}
+	if resp.ClusterID == "" {
+		return nil, client.ErrMissingID
+	}
+	return c.GetCluster(ctx, resp.ClusterID)
+}
+
+// GetCluster returns the cluster with the given ID.
+func (c *Client) GetCluster(ctx context.Context, id string) (*client.Cluster, error) {
+	var info clusterInfo
+	if err := c.get(ctx, "/api/2.0/clusters/get", url.Values{"cluster_id": {id}}, &info); err != nil {
+		return nil, err
+	}
+
+	cluster := &client.Cluster{
+		ClusterSettings: client.ClusterSettings{
+			ClusterName:            info.ClusterName,
+			SparkVersion:           info.SparkVersion,
+			NodeTypeID:             info.NodeTypeID,
+			DriverNodeTypeID:       info.DriverNodeTypeID,
+			NumWorkers:             info.NumWorkers,
+			AutoterminationMinutes: info.AutoterminationMinutes,
+			SparkConf:              info.SparkConf,
+			CustomTags:             info.CustomTags,
+			PolicyID:               info.PolicyID,
+		},
+		ID:           client.FlexString(info.ClusterID),
+		ClusterID:    client.FlexString(info.ClusterID),
+		State:        info.State,
+		StateMessage: info.StateMessage,
+		CreatedTime:  info.StartTime,
+	}
+	if info.Autoscale != nil {
+		cluster.Autoscale = &client.AutoScale{MinWorkers: info.Autoscale.MinWorkers, MaxWorkers: info.Autoscale.MaxWorkers}
+	}
+	return cluster, nil
+}
+
+// UpdateCluster replaces the settings of the cluster with the given ID.
+func (c *Client) UpdateCluster(ctx context.Context, id string, req *client.ClusterUpdateRequest) error {
+	return c.post(ctx, "/api/2.0/clusters/edit", toClusterSettings(id, req.ClusterSettings), nil)
+}
+
+// ResizeCluster changes the size of a running cluster without restarting it.
+func (c *Client) ResizeCluster(ctx context.Context, id string, req *client.ClusterResizeRequest) error {
+	return c.post(ctx, "/api/2.0/clusters/resize", &clusterResize{
+		ClusterID:  id,
+		NumWorkers: req.NumWorkers,
+		Autoscale:  toAutoScale(req.Autoscale),
+	}, nil)
+}
+
+// DeleteCluster terminates the cluster with the given ID.
+func (c *Client) DeleteCluster(ctx context.Context, id string) error {
+	return c.post(ctx, "/api/2.0/clusters/delete", map[string]string{"cluster_id": id}, nil)
+}
+
+// WaitForClusterRunning polls the cluster until it is RUNNING, like
+// client.ProjectClient.WaitForClusterRunning.
+func (c *Client) WaitForClusterRunning(ctx context.Context, id string) (*client.Cluster, error) {
+	return client.WaitClusterRunning(ctx, c, c.PollInterval, id)
+}
+
+// WaitForClusterTerminated polls the cluster until it is TERMINATED or no
+// longer exists.
+func (c *Client) WaitForClusterTerminated(ctx context.Context, id string) error {
+	return client.WaitClusterTerminated(ctx, c, c.PollInterval, id)
+}
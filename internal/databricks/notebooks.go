@@ -0,0 +1,81 @@
+package databricks
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+)
+
+// The workspace API identifies notebooks by their path, which is also used as
+// their ID.
+
+type notebookImport struct {
+	Path      string `json:"path"`
+	Format    string `json:"format"`
+	Language  string `json:"language,omitempty"`
+	Content   string `json:"content"`
+	Overwrite bool   `json:"overwrite"`
+}
+
+type objectStatus struct {
+	ObjectType string            `json:"object_type"`
+	ObjectID   client.FlexString `json:"object_id"`
+	Path       string            `json:"path"`
+	Language   string            `json:"language"`
+	CreatedAt  client.FlexString `json:"created_at"`
+}
+
+// CreateNotebook imports a notebook. It fails if the path already exists.
+func (c *Client) CreateNotebook(ctx context.Context, req *client.NotebookCreateRequest) (*client.Notebook, error) {
+	if err := c.importNotebook(ctx, req.Path, req.Language, req.Content, req.Format, false); err != nil {
+		return nil, err
+	}
+	return c.GetNotebook(ctx, req.Path)
+}
+
+// GetNotebook returns the notebook at the given path. The content is not
+// exported.
+func (c *Client) GetNotebook(ctx context.Context, id string) (*client.Notebook, error) {
+	var status objectStatus
+	if err := c.get(ctx, "/api/2.0/workspace/get-status", url.Values{"path": {id}}, &status); err != nil {
+		return nil, err
+	}
+	return &client.Notebook{
+		ID:          client.FlexString(status.Path),
+		Path:        status.Path,
+		Language:    status.Language,
+		NotebookID:  status.ObjectID,
+		CreatedTime: status.CreatedAt,
+	}, nil
+}
+
+// UpdateNotebook overwrites the notebook at the given path. The workspace API
+// cannot move a notebook, so the path of the request must be the same.
+func (c *Client) UpdateNotebook(ctx context.Context, id string, req *client.NotebookUpdateRequest) error {
+	if req.Path != id {
+		return fmt.Errorf("cannot move notebook %s to %s", id, req.Path)
+	}
+	return c.importNotebook(ctx, req.Path, req.Language, req.Content, req.Format, true)
+}
+
+// DeleteNotebook deletes the notebook at the given path.
+func (c *Client) DeleteNotebook(ctx context.Context, id string) error {
+	return c.post(ctx, "/api/2.0/workspace/delete", map[string]any{"path": id, "recursive": false}, nil)
+}
+
+func (c *Client) importNotebook(ctx context.Context, path, language, content, format string, overwrite bool) error {
+	if format == "" {
+		format = "SOURCE"
+	}
+	return c.post(ctx, "/api/2.0/workspace/import", &notebookImport{
+		Path:      path,
+		Format:    strings.ToUpper(format),
+		Language:  strings.ToUpper(language),
+		Content:   base64.StdEncoding.EncodeToString([]byte(content)),
+		Overwrite: overwrite,
+	}, nil)
+}
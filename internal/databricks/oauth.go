@@ -0,0 +1,126 @@
+package databricks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AccountsHost is the host of the Databricks account console.
+const AccountsHost = "https://accounts.cloud.databricks.com"
+
+// tokenExpiryDelta is how long before its expiry a token is refreshed, so
+// that it does not expire while a request is in flight.
+const tokenExpiryDelta = time.Minute
+
+// defaultTokenLifetime is how long a token is used when the token endpoint
+// does not return its lifetime, as Databricks tokens last one hour.
+const defaultTokenLifetime = time.Hour
+
+// WorkspaceTokenURL returns the OAuth token endpoint of the workspace at host.
+func WorkspaceTokenURL(host string) string {
+	return normalizeHost(host) + "/oidc/v1/token"
+}
+
+// AccountTokenURL returns the OAuth token endpoint of a Databricks account.
+// Its tokens are valid for every workspace of the account the service
+// principal has access to.
+func AccountTokenURL(accountID string) string {
+	return AccountsHost + "/oidc/accounts/" + url.PathEscape(accountID) + "/v1/token"
+}
+
+// TokenSource obtains access tokens for a service principal with the OAuth
+// client-credentials flow. Tokens are cached and refreshed shortly before
+// they expire; a TokenSource is safe for concurrent use.
+type TokenSource struct {
+	tokenURL     string
+	clientID     string
+	clientSecret string
+	http         *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// NewTokenSource returns a TokenSource requesting tokens from tokenURL. A nil
+// httpClient uses http.DefaultClient.
+func NewTokenSource(tokenURL, clientID, clientSecret string, httpClient *http.Client) *TokenSource {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &TokenSource{
+		tokenURL:     tokenURL,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		http:         httpClient,
+	}
+}
+
+// Token returns a valid access token, requesting a new one when the cached
+// token is missing or about to expire.
+func (s *TokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && time.Now().Add(tokenExpiryDelta).Before(s.expiry) {
+		return s.token, nil
+	}
+
+	form := url.Values{
+		"grant_type": {"client_credentials"},
+		"scope":      {"all-apis"},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(s.clientID, s.clientSecret)
+
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("requesting OAuth token from %s: %w", s.tokenURL, err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("requesting OAuth token from %s: %w", s.tokenURL, err)
+	}
+
+	var body struct {
+		AccessToken      string `json:"access_token"`
+		ExpiresIn        int64  `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.Unmarshal(b, &body); err != nil && resp.StatusCode < 300 {
+		return "", fmt.Errorf("decoding OAuth token from %s: %w", s.tokenURL, err)
+	}
+	if resp.StatusCode >= 300 {
+		if body.Error == "" {
+			return "", fmt.Errorf("requesting OAuth token from %s: HTTP %d: %s", s.tokenURL, resp.StatusCode, strings.TrimSpace(string(b)))
+		}
+		return "", fmt.Errorf("requesting OAuth token from %s: %s: %s", s.tokenURL, body.Error, body.ErrorDescription)
+	}
+	if body.AccessToken == "" {
+		return "", fmt.Errorf("requesting OAuth token from %s: response does not contain an access token", s.tokenURL)
+	}
+
+	lifetime := time.Duration(body.ExpiresIn) * time.Second
+	if lifetime <= 0 {
+		lifetime = defaultTokenLifetime
+	}
+
+	s.token = body.AccessToken
+	s.expiry = time.Now().Add(lifetime)
+	return s.token, nil
+}
@@ -0,0 +1,73 @@
+package databricks
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// AccessControl is the permission of a user, group or service principal on a
+// workspace object. Exactly one of the principal fields is set.
+type AccessControl struct {
+	UserName             string `json:"user_name,omitempty"`
+	GroupName            string `json:"group_name,omitempty"`
+	ServicePrincipalName string `json:"service_principal_name,omitempty"`
+	PermissionLevel      string `json:"permission_level"`
+}
+
+// ObjectPermissions are the permissions of a workspace object.
+type ObjectPermissions struct {
+	ObjectID          string          `json:"object_id"`
+	ObjectType        string          `json:"object_type"`
+	AccessControlList []AccessControl `json:"access_control_list"`
+}
+
+type objectPermissionsResponse struct {
+	ObjectID          string `json:"object_id"`
+	ObjectType        string `json:"object_type"`
+	AccessControlList []struct {
+		UserName             string `json:"user_name"`
+		GroupName            string `json:"group_name"`
+		ServicePrincipalName string `json:"service_principal_name"`
+		AllPermissions       []struct {
+			PermissionLevel string `json:"permission_level"`
+			Inherited       bool   `json:"inherited"`
+		} `json:"all_permissions"`
+	} `json:"access_control_list"`
+}
+
+// permissionsPath returns the path of the permissions of an object, such as
+// ("clusters", "0123-456789-abcdef") or ("notebooks", "1234").
+func permissionsPath(objectType, objectID string) string {
+	return "/api/2.0/permissions/" + objectType + "/" + url.PathEscape(objectID)
+}
+
+// GetPermissions returns the permissions set directly on an object. Inherited
+// permissions are left out.
+func (c *Client) GetPermissions(ctx context.Context, objectType, objectID string) (*ObjectPermissions, error) {
+	var resp objectPermissionsResponse
+	if err := c.get(ctx, permissionsPath(objectType, objectID), nil, &resp); err != nil {
+		return nil, err
+	}
+
+	perms := &ObjectPermissions{ObjectID: resp.ObjectID, ObjectType: resp.ObjectType}
+	for _, acl := range resp.AccessControlList {
+		for _, p := range acl.AllPermissions {
+			if p.Inherited {
+				continue
+			}
+			perms.AccessControlList = append(perms.AccessControlList, AccessControl{
+				UserName:             acl.UserName,
+				GroupName:            acl.GroupName,
+				ServicePrincipalName: acl.ServicePrincipalName,
+				PermissionLevel:      p.PermissionLevel,
+			})
+		}
+	}
+	return perms, nil
+}
+
+// SetPermissions replaces the permissions set directly on an object.
+func (c *Client) SetPermissions(ctx context.Context, objectType, objectID string, acl []AccessControl) error {
+	return c.call(ctx, http.MethodPut, permissionsPath(objectType, objectID), map[string]any{"access_control_list": acl}, nil)
+}
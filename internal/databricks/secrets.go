@@ -0,0 +1,81 @@
+package databricks
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+)
+
+type secretMetadata struct {
+	Key                  string `json:"key"`
+	LastUpdatedTimestamp int64  `json:"last_updated_timestamp"`
+}
+
+// PutSecret creates or overwrites a secret of the scope.
+func (c *Client) PutSecret(ctx context.Context, scope string, req *client.SecretPutRequest) error {
+	return c.post(ctx, "/api/2.0/secrets/put", map[string]string{
+		"scope":        scope,
+		"key":          req.Key,
+		"string_value": req.StringValue,
+	}, nil)
+}
+
+// ListSecrets returns the metadata of the secrets of the scope.
+func (c *Client) ListSecrets(ctx context.Context, scope string) ([]client.SecretMetadata, error) {
+	var resp struct {
+		Secrets []secretMetadata `json:"secrets"`
+	}
+	if err := c.get(ctx, "/api/2.0/secrets/list", url.Values{"scope": {scope}}, &resp); err != nil {
+		return nil, err
+	}
+
+	secrets := make([]client.SecretMetadata, 0, len(resp.Secrets))
+	for _, s := range resp.Secrets {
+		secrets = append(secrets, client.SecretMetadata{Key: s.Key, LastUpdatedTimestamp: s.LastUpdatedTimestamp})
+	}
+	return secrets, nil
+}
+
+// GetSecret returns the metadata of a secret. The value cannot be read back.
+func (c *Client) GetSecret(ctx context.Context, scope, key string) (*client.SecretMetadata, error) {
+	secrets, err := c.ListSecrets(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	for i := range secrets {
+		if secrets[i].Key == key {
+			return &secrets[i], nil
+		}
+	}
+	return nil, client.ErrNotFound
+}
+
+// DeleteSecret deletes a secret of the scope.
+func (c *Client) DeleteSecret(ctx context.Context, scope, key string) error {
+	return c.post(ctx, "/api/2.0/secrets/delete", map[string]string{"scope": scope, "key": key}, nil)
+}
+
+// PutSecretACL grants a permission on the scope to a principal, replacing the
+// one it had.
+func (c *Client) PutSecretACL(ctx context.Context, scope string, acl *client.SecretACL) error {
+	return c.post(ctx, "/api/2.0/secrets/acls/put", map[string]string{
+		"scope":      scope,
+		"principal":  acl.Principal,
+		"permission": acl.Permission,
+	}, nil)
+}
+
+// GetSecretACL returns the permission of a principal on the scope.
+func (c *Client) GetSecretACL(ctx context.Context, scope, principal string) (*client.SecretACL, error) {
+	var acl client.SecretACL
+	if err := c.get(ctx, "/api/2.0/secrets/acls/get", url.Values{"scope": {scope}, "principal": {principal}}, &acl); err != nil {
+		return nil, err
+	}
+	return &acl, nil
+}
+
+// DeleteSecretACL revokes the permission of a principal on the scope.
+func (c *Client) DeleteSecretACL(ctx context.Context, scope, principal string) error {
+	return c.post(ctx, "/api/2.0/secrets/acls/delete", map[string]string{"scope": scope, "principal": principal}, nil)
+}
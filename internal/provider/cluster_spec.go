@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+)
+
+// ClusterSpecModel describes a new_cluster block.
+type ClusterSpecModel struct {
+	SparkVersion types.String     `tfsdk:"spark_version"`
+	NodeTypeID   types.String     `tfsdk:"node_type_id"`
+	NumWorkers   types.Int64      `tfsdk:"num_workers"`
+	Autoscale    []AutoScaleModel `tfsdk:"autoscale"`
+	PolicyID     types.String     `tfsdk:"policy_id"`
+}
+
+// AutoScaleModel describes an autoscale block.
+type AutoScaleModel struct {
+	MinWorkers types.Int64 `tfsdk:"min_workers"`
+	MaxWorkers types.Int64 `tfsdk:"max_workers"`
+}
+
+func clusterSpecBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: "New cluster configuration",
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"spark_version": schema.StringAttribute{
+					Description: "Spark version",
+					Required:    true,
+				},
+				"node_type_id": schema.StringAttribute{
+					Description: "Node type ID",
+					Required:    true,
+				},
+				"num_workers": schema.Int64Attribute{
+					Description: "Number of workers",
+					Optional:    true,
+				},
+				"policy_id": schema.StringAttribute{
+					Description: "Databricks ID of the cluster policy the cluster must comply with, usually the policy_id of a databricks-ovh_cluster_policy. " +
+						"When the policy already exists, spark_version, node_type_id, num_workers and autoscale are checked against it at plan time. " +
+						"Other rules, and policies created in the same apply, are only checked by Databricks",
+					Optional: true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"autoscale": autoScaleBlock(),
+			},
+		},
+	}
+}
+
+func autoScaleBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: "Autoscale configuration",
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"min_workers": schema.Int64Attribute{
+					Description: "Minimum workers",
+					Required:    true,
+				},
+				"max_workers": schema.Int64Attribute{
+					Description: "Maximum workers",
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+func expandClusterSpec(specs []ClusterSpecModel) *client.ClusterSpec {
+	if len(specs) == 0 {
+		return nil
+	}
+
+	spec := specs[0]
+	return &client.ClusterSpec{
+		SparkVersion: spec.SparkVersion.ValueString(),
+		NodeTypeID:   spec.NodeTypeID.ValueString(),
+		NumWorkers:   spec.NumWorkers.ValueInt64Pointer(),
+		Autoscale:    expandAutoScale(spec.Autoscale),
+		PolicyID:     spec.PolicyID.ValueString(),
+	}
+}
+
+func expandAutoScale(scales []AutoScaleModel) *client.AutoScale {
+	if len(scales) == 0 {
+		return nil
+	}
+
+	return &client.AutoScale{
+		MinWorkers: scales[0].MinWorkers.ValueInt64(),
+		MaxWorkers: scales[0].MaxWorkers.ValueInt64(),
+	}
+}
+
+func flattenClusterSpec(spec *client.ClusterSpec) []ClusterSpecModel {
+	if spec == nil {
+		return []ClusterSpecModel{}
+	}
+
+	return []ClusterSpecModel{{
+		SparkVersion: types.StringValue(spec.SparkVersion),
+		NodeTypeID:   types.StringValue(spec.NodeTypeID),
+		NumWorkers:   types.Int64PointerValue(spec.NumWorkers),
+		Autoscale:    flattenAutoScale(spec.Autoscale),
+		PolicyID:     stringValueOrNull(spec.PolicyID),
+	}}
+}
+
+func flattenAutoScale(scale *client.AutoScale) []AutoScaleModel {
+	if scale == nil {
+		return []AutoScaleModel{}
+	}
+
+	return []AutoScaleModel{{
+		MinWorkers: types.Int64Value(scale.MinWorkers),
+		MaxWorkers: types.Int64Value(scale.MaxWorkers),
+	}}
+}
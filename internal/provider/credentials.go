@@ -0,0 +1,414 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/databricks"
+	"gopkg.in/ini.v1"
+)
+
+// defaultOVHEndpoint is the endpoint used when none is configured, as in
+// go-ovh.
+const defaultOVHEndpoint = "ovh-eu"
+
+// ovhConfigPaths returns the ovh.conf files read by go-ovh and the other OVH
+// SDKs, by decreasing priority.
+func ovhConfigPaths() []string {
+	paths := []string{"./ovh.conf"}
+	if home, err := os.UserHomeDir(); err == nil {
+		paths = append(paths, filepath.Join(home, ".ovh.conf"))
+	}
+	return append(paths, "/etc/ovh.conf")
+}
+
+// ovhConfigFile is a parsed ovh.conf file. Its [default] section holds the
+// endpoint; the credentials are in the section named after the endpoint.
+type ovhConfigFile struct {
+	path string
+	ini  *ini.File
+}
+
+// loadOVHConfig parses the files that exist among paths.
+func loadOVHConfig(paths []string) ([]ovhConfigFile, error) {
+	var files []ovhConfigFile
+	for _, p := range paths {
+		f, err := ini.Load(p)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", p, err)
+		}
+		files = append(files, ovhConfigFile{path: p, ini: f})
+	}
+	return files, nil
+}
+
+// settingResolver resolves provider settings from the provider
+// configuration, then the environment, then the ovh.conf files, and records
+// where each one was found.
+type settingResolver struct {
+	files   []ovhConfigFile
+	sources map[string]string
+}
+
+// resolve returns the value of attribute, or "" when it is set nowhere. An
+// empty section skips the ovh.conf files.
+func (r *settingResolver) resolve(attribute string, value types.String, env, section, key string) string {
+	if v := value.ValueString(); v != "" {
+		r.sources[attribute] = "the provider configuration"
+		return v
+	}
+	if v := os.Getenv(env); v != "" {
+		r.sources[attribute] = "the " + env + " environment variable"
+		return v
+	}
+	if section == "" {
+		return ""
+	}
+	for _, f := range r.files {
+		if s, err := f.ini.GetSection(section); err == nil && s.HasKey(key) && s.Key(key).String() != "" {
+			r.sources[attribute] = fmt.Sprintf("%s in the [%s] section of %s", key, section, f.path)
+			return s.Key(key).String()
+		}
+	}
+	return ""
+}
+
+// describe lists where each resolved setting was found.
+func (r *settingResolver) describe() string {
+	attributes := make([]string, 0, len(r.sources))
+	for attribute := range r.sources {
+		attributes = append(attributes, attribute)
+	}
+	sort.Strings(attributes)
+
+	lines := make([]string, len(attributes))
+	for i, attribute := range attributes {
+		lines[i] = "- " + attribute + ": " + r.sources[attribute]
+	}
+	return "Settings were read from:\n" + strings.Join(lines, "\n")
+}
+
+// ovhCredentials are the resolved OVH credentials of the provider: either an
+// application key, secret and consumer key, or the client ID and secret of an
+// OAuth2 service account.
+type ovhCredentials struct {
+	ApplicationKey    string
+	ApplicationSecret string
+	ConsumerKey       string
+	ClientID          string
+	ClientSecret      string
+
+	// Section is the ovh.conf section the credentials are looked up in, and
+	// Sources where each attribute was found, for the diagnostics.
+	Section string
+	Sources map[string]string
+}
+
+// ovhCredential describes one credential attribute for the diagnostics.
+type ovhCredential struct {
+	attribute string
+	env       string
+	key       string
+	title     string
+	value     string
+}
+
+func (c ovhCredentials) applicationKeys() []ovhCredential {
+	return []ovhCredential{
+		{"ovh_application_key", "OVH_APPLICATION_KEY", "application_key", "Application Key", c.ApplicationKey},
+		{"ovh_application_secret", "OVH_APPLICATION_SECRET", "application_secret", "Application Secret", c.ApplicationSecret},
+		{"ovh_consumer_key", "OVH_CONSUMER_KEY", "consumer_key", "Consumer Key", c.ConsumerKey},
+	}
+}
+
+func (c ovhCredentials) oauth2() []ovhCredential {
+	return []ovhCredential{
+		{"ovh_client_id", "OVH_CLIENT_ID", "client_id", "Client ID", c.ClientID},
+		{"ovh_client_secret", "OVH_CLIENT_SECRET", "client_secret", "Client Secret", c.ClientSecret},
+	}
+}
+
+// resolveOVHCredentials resolves the OVH credentials of the provider
+// configuration in the given ovh.conf section.
+func resolveOVHCredentials(r *settingResolver, config DatabricksOVHProviderModel, section string) ovhCredentials {
+	return ovhCredentials{
+		ApplicationKey:    r.resolve("ovh_application_key", config.OVHApplicationKey, "OVH_APPLICATION_KEY", section, "application_key"),
+		ApplicationSecret: r.resolve("ovh_application_secret", config.OVHApplicationSecret, "OVH_APPLICATION_SECRET", section, "application_secret"),
+		ConsumerKey:       r.resolve("ovh_consumer_key", config.OVHConsumerKey, "OVH_CONSUMER_KEY", section, "consumer_key"),
+		ClientID:          r.resolve("ovh_client_id", config.OVHClientID, "OVH_CLIENT_ID", section, "client_id"),
+		ClientSecret:      r.resolve("ovh_client_secret", config.OVHClientSecret, "OVH_CLIENT_SECRET", section, "client_secret"),
+		Section:           section,
+		Sources:           r.sources,
+	}
+}
+
+// usesOAuth2 reports whether the client ID and secret are both set.
+func (c ovhCredentials) usesOAuth2() bool {
+	return c.ClientID != "" && c.ClientSecret != ""
+}
+
+// newClient returns an OVH client authenticated with the credentials.
+func (c ovhCredentials) newClient(endpoint string) (*ovh.Client, error) {
+	if c.usesOAuth2() {
+		return ovh.NewOAuth2Client(endpoint, c.ClientID, c.ClientSecret)
+	}
+	return ovh.NewClient(endpoint, c.ApplicationKey, c.ApplicationSecret, c.ConsumerKey)
+}
+
+// describeSources lists where the set credentials were found.
+func (c ovhCredentials) describeSources(creds []ovhCredential) string {
+	var found []string
+	for _, cred := range creds {
+		if cred.value != "" && c.Sources[cred.attribute] != "" {
+			found = append(found, cred.attribute+" was read from "+c.Sources[cred.attribute])
+		}
+	}
+	if len(found) == 0 {
+		return ""
+	}
+	return " " + strings.Join(found, "; ") + "."
+}
+
+// validate checks that exactly one authentication method is complete, and
+// that no attribute of another method is set: go-ovh reads the OVH_*
+// environment variables again when it creates the client, and refuses
+// credentials of several methods. When no method is complete, the missing
+// attributes of the partially configured ones are reported.
+func (c ovhCredentials) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	methods := [][]ovhCredential{c.applicationKeys(), c.oauth2()}
+
+	var complete, partial [][]ovhCredential
+	for _, method := range methods {
+		set := 0
+		for _, cred := range method {
+			if cred.value != "" {
+				set++
+			}
+		}
+		switch set {
+		case len(method):
+			complete = append(complete, method)
+		case 0:
+		default:
+			partial = append(partial, method)
+		}
+	}
+
+	section := c.Section
+	if section == "" {
+		section = "<endpoint>"
+	}
+
+	switch {
+	case len(complete) > 1:
+		diags.AddAttributeError(
+			path.Root("ovh_client_id"),
+			"Conflicting OVH Authentication Methods",
+			"Both application keys (ovh_application_key, ovh_application_secret and ovh_consumer_key) and OAuth2 client credentials "+
+				"(ovh_client_id and ovh_client_secret) are configured. Configure only one of them."+
+				c.describeSources(append(complete[0], complete[1]...)),
+		)
+	case len(complete) == 1 && len(partial) > 0:
+		var set []string
+		for _, cred := range partial[0] {
+			if cred.value != "" {
+				set = append(set, cred.attribute)
+			}
+		}
+		other := "application keys"
+		if complete[0][0].attribute == "ovh_application_key" {
+			other = "OAuth2 client credentials"
+		}
+		diags.AddAttributeError(
+			path.Root(set[0]),
+			"Conflicting OVH Authentication Methods",
+			fmt.Sprintf("%s is set although another authentication method is complete, and the OVH client refuses to mix them. "+
+				"Unset %s, or unset the other method and complete the %s.", strings.Join(set, " and "), strings.Join(set, " and "), other)+
+				c.describeSources(partial[0]),
+		)
+	case len(complete) == 1:
+	case len(partial) > 0:
+		for _, method := range partial {
+			for _, cred := range method {
+				if cred.value != "" {
+					continue
+				}
+				diags.AddAttributeError(
+					path.Root(cred.attribute),
+					"Missing OVH "+cred.title,
+					fmt.Sprintf("The provider requires an OVH %s to be configured. Set %s, the %s environment variable, or %s in the [%s] section of ovh.conf.",
+						cred.title, cred.attribute, cred.env, cred.key, section)+c.describeSources(method),
+				)
+			}
+		}
+	default:
+		diags.AddError(
+			"Missing OVH Credentials",
+			"The provider requires OVH credentials: either ovh_application_key, ovh_application_secret and ovh_consumer_key, "+
+				"or ovh_client_id and ovh_client_secret for an OAuth2 service account. They can also be set with the "+
+				"OVH_APPLICATION_KEY, OVH_APPLICATION_SECRET, OVH_CONSUMER_KEY, OVH_CLIENT_ID and OVH_CLIENT_SECRET environment variables, "+
+				"or in the ["+section+"] section of ovh.conf.",
+		)
+	}
+
+	return diags
+}
+
+// databricksAuthAttributes names the provider attributes of each Databricks
+// authentication method.
+var databricksAuthAttributes = map[string]string{
+	databricks.AuthTypePAT:      "databricks_token",
+	databricks.AuthTypeOAuthM2M: "databricks_client_id and databricks_client_secret",
+	databricks.AuthTypeBasic:    "databricks_username and databricks_password",
+}
+
+// validateDatabricksCredentials reports half-configured Databricks
+// credentials, and warns when several methods are configured as only the one
+// with the highest precedence is used.
+func validateDatabricksCredentials(creds databricks.Credentials) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if (creds.ClientID == "") != (creds.ClientSecret == "") {
+		missing := "databricks_client_secret"
+		if creds.ClientID == "" {
+			missing = "databricks_client_id"
+		}
+		diags.AddAttributeError(
+			path.Root(missing),
+			"Incomplete Databricks OAuth Credentials",
+			"databricks_client_id and databricks_client_secret must be set together. Set "+missing+
+				" or the "+strings.ToUpper(missing)+" environment variable.",
+		)
+	}
+
+	if (creds.Username == "") != (creds.Password == "") {
+		missing := "databricks_password"
+		if creds.Username == "" {
+			missing = "databricks_username"
+		}
+		diags.AddAttributeError(
+			path.Root(missing),
+			"Incomplete Databricks Basic Credentials",
+			"databricks_username and databricks_password must be set together. Set "+missing+
+				" or the "+strings.ToUpper(missing)+" environment variable.",
+		)
+	}
+
+	if methods := creds.AuthTypes(); len(methods) > 1 {
+		names := make([]string, len(methods))
+		for i, method := range methods {
+			names[i] = databricksAuthAttributes[method]
+		}
+		diags.AddWarning(
+			"Multiple Databricks Authentication Methods",
+			fmt.Sprintf("Credentials are configured with %s. Only %s is used: a personal access token takes precedence "+
+				"over OAuth client credentials, which take precedence over a username and password.",
+				strings.Join(names, ", "), names[0]),
+		)
+	}
+
+	return diags
+}
+
+// credentialExpiryWarning is how long before their expiration the provider
+// warns about OVH credentials.
+const credentialExpiryWarning = 7 * 24 * time.Hour
+
+// validateOVHCredential checks that the consumer key of the client is
+// validated and not expired, and warns when it expires soon or when its
+// access rules do not cover the Databricks API of the default project.
+func validateOVHCredential(ctx context.Context, api *client.Client, projectID string, now time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	credential, err := api.CurrentCredential(ctx)
+	var apiErr *ovh.APIError
+	if errors.As(err, &apiErr) && (apiErr.Code == http.StatusUnauthorized || apiErr.Code == http.StatusForbidden) {
+		diags.AddAttributeError(
+			path.Root("ovh_consumer_key"),
+			"Invalid OVH Credentials",
+			fmt.Sprintf("The OVH API rejected the application key or consumer key: %s. "+
+				"Check ovh_application_key, ovh_application_secret and ovh_consumer_key, and that the consumer key was not revoked.", apiErr.Message),
+		)
+		return diags
+	}
+	if err != nil {
+		diags.AddError(
+			"Unable to Validate OVH Credentials",
+			fmt.Sprintf("The provider could not read the OVH credentials from /auth/currentCredential, got error: %s\n\n"+
+				"Set skip_credentials_validation to configure the provider without calling the OVH API.", err),
+		)
+		return diags
+	}
+
+	expired := credential.Status == client.CredentialStatusExpired ||
+		(credential.Expiration != nil && !credential.Expiration.After(now))
+
+	switch {
+	case expired:
+		diags.AddAttributeError(
+			path.Root("ovh_consumer_key"),
+			"Expired OVH Consumer Key",
+			"The OVH consumer key has expired. Create a new consumer key with the access rules of the provider and set ovh_consumer_key.",
+		)
+		return diags
+	case credential.Status != client.CredentialStatusValidated:
+		diags.AddAttributeError(
+			path.Root("ovh_consumer_key"),
+			"Invalid OVH Consumer Key",
+			fmt.Sprintf("The OVH consumer key is %s instead of validated. A new consumer key must be validated by opening the "+
+				"validation URL returned when it was requested.", credential.Status),
+		)
+		return diags
+	}
+
+	if credential.Expiration != nil && credential.Expiration.Sub(now) < credentialExpiryWarning {
+		diags.AddAttributeWarning(
+			path.Root("ovh_consumer_key"),
+			"OVH Consumer Key Expires Soon",
+			fmt.Sprintf("The OVH consumer key expires on %s, in %s. Create a new consumer key before then to avoid failing runs.",
+				credential.Expiration.Format(time.RFC3339), credential.Expiration.Sub(now).Round(time.Minute)),
+		)
+	}
+
+	// Resources may target other projects, which cannot be checked here.
+	if projectID == "" {
+		return diags
+	}
+
+	pattern := "/cloud/project/" + projectID + "/databricks/*"
+	var missing []string
+	for _, rule := range requiredOVHAccessRules {
+		method, _, _ := strings.Cut(rule, " ")
+		if !credential.Allows(method, pattern) {
+			missing = append(missing, method+" "+pattern)
+		}
+	}
+	if len(missing) > 0 {
+		diags.AddAttributeWarning(
+			path.Root("ovh_consumer_key"),
+			"Incomplete OVH Access Rules",
+			"The OVH consumer key does not grant the following access rules, so the operations that need them will fail with a 403 error:\n  - "+
+				strings.Join(missing, "\n  - ")+"\n\nCreate a new consumer key with these rules.",
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ovh/go-ovh/ovh"
+
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/databricks"
+)
+
+func TestValidateOVHCredentials(t *testing.T) {
+	tests := map[string]struct {
+		creds  ovhCredentials
+		errors int
+	}{
+		"none":                 {errors: 1},
+		"application keys":     {creds: ovhCredentials{ApplicationKey: "ak", ApplicationSecret: "as", ConsumerKey: "ck"}},
+		"oauth2":               {creds: ovhCredentials{ClientID: "id", ClientSecret: "s"}},
+		"missing consumer key": {creds: ovhCredentials{ApplicationKey: "ak", ApplicationSecret: "as"}, errors: 1},
+		"missing both secrets": {creds: ovhCredentials{ApplicationKey: "ak", ClientID: "id"}, errors: 3},
+		"oauth2 with partial keys": {
+			creds:  ovhCredentials{ApplicationKey: "ak", ClientID: "id", ClientSecret: "s"},
+			errors: 1,
+		},
+		"keys with partial oauth2": {
+			creds:  ovhCredentials{ApplicationKey: "ak", ApplicationSecret: "as", ConsumerKey: "ck", ClientID: "id"},
+			errors: 1,
+		},
+		"both": {
+			creds:  ovhCredentials{ApplicationKey: "ak", ApplicationSecret: "as", ConsumerKey: "ck", ClientID: "id", ClientSecret: "s"},
+			errors: 1,
+		},
+	}
+
+	for name, tt := range tests {
+		diags := tt.creds.validate()
+		if got := diags.ErrorsCount(); got != tt.errors {
+			t.Errorf("%s: got %d errors, want %d: %v", name, got, tt.errors, diags)
+		}
+	}
+}
+
+func TestResolveOVHCredentials(t *testing.T) {
+	dir := t.TempDir()
+	home := filepath.Join(dir, ".ovh.conf")
+	local := filepath.Join(dir, "ovh.conf")
+
+	if err := os.WriteFile(home, []byte("[default]\nendpoint=ovh-ca\n\n[ovh-ca]\napplication_key=home-ak\napplication_secret=home-as\nconsumer_key=home-ck\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(local, []byte("[ovh-ca]\nconsumer_key=local-ck\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, env := range []string{"OVH_ENDPOINT", "OVH_APPLICATION_KEY", "OVH_APPLICATION_SECRET", "OVH_CONSUMER_KEY", "OVH_CLIENT_ID", "OVH_CLIENT_SECRET"} {
+		t.Setenv(env, "")
+	}
+	t.Setenv("OVH_APPLICATION_SECRET", "env-as")
+
+	files, err := loadOVHConfig([]string{local, home, filepath.Join(dir, "missing.conf")})
+	if err != nil {
+		t.Fatalf("loading ovh.conf: %s", err)
+	}
+
+	resolver := &settingResolver{files: files, sources: make(map[string]string)}
+	config := DatabricksOVHProviderModel{OVHApplicationKey: types.StringValue("config-ak")}
+
+	endpoint := resolver.resolve("ovh_endpoint", config.OVHEndpoint, "OVH_ENDPOINT", "default", "endpoint")
+	if endpoint != "ovh-ca" {
+		t.Fatalf("got endpoint %q", endpoint)
+	}
+
+	creds := resolveOVHCredentials(resolver, config, endpoint)
+	if creds.ApplicationKey != "config-ak" || creds.ApplicationSecret != "env-as" || creds.ConsumerKey != "local-ck" {
+		t.Errorf("unexpected credentials %+v", creds)
+	}
+	if diags := creds.validate(); diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+
+	wantSources := map[string]string{
+		"ovh_endpoint":           "endpoint in the [default] section of " + home,
+		"ovh_application_key":    "the provider configuration",
+		"ovh_application_secret": "the OVH_APPLICATION_SECRET environment variable",
+		"ovh_consumer_key":       "consumer_key in the [ovh-ca] section of " + local,
+	}
+	for attribute, want := range wantSources {
+		if got := creds.Sources[attribute]; got != want {
+			t.Errorf("%s: got source %q, want %q", attribute, got, want)
+		}
+	}
+
+	missing := ovhCredentials{ApplicationKey: "ak", Section: "ovh-ca", Sources: map[string]string{"ovh_application_key": "the provider configuration"}}.validate()
+	if missing.ErrorsCount() != 2 || !strings.Contains(missing[0].Detail(), "ovh_application_key was read from the provider configuration") {
+		t.Errorf("unexpected diagnostics: %v", missing)
+	}
+}
+
+func TestValidateDatabricksCredentials(t *testing.T) {
+	tests := map[string]struct {
+		creds    databricks.Credentials
+		errors   int
+		warnings int
+	}{
+		"none":             {},
+		"token":            {creds: databricks.Credentials{Token: "t"}},
+		"oauth":            {creds: databricks.Credentials{ClientID: "id", ClientSecret: "s"}},
+		"missing secret":   {creds: databricks.Credentials{ClientID: "id"}, errors: 1},
+		"missing username": {creds: databricks.Credentials{Password: "p"}, errors: 1},
+		"token and oauth":  {creds: databricks.Credentials{Token: "t", ClientID: "id", ClientSecret: "s"}, warnings: 1},
+	}
+
+	for name, tt := range tests {
+		diags := validateDatabricksCredentials(tt.creds)
+		if got := diags.ErrorsCount(); got != tt.errors {
+			t.Errorf("%s: got %d errors, want %d: %v", name, got, tt.errors, diags)
+		}
+		if got := diags.WarningsCount(); got != tt.warnings {
+			t.Errorf("%s: got %d warnings, want %d: %v", name, got, tt.warnings, diags)
+		}
+	}
+}
+
+func TestValidateOVHCredential(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	allRules := `[{"method":"GET","path":"/*"},{"method":"POST","path":"/*"},{"method":"PUT","path":"/*"},{"method":"DELETE","path":"/*"}]`
+
+	tests := map[string]struct {
+		status   int
+		body     string
+		errors   []string
+		warnings []string
+	}{
+		"validated": {
+			body: `{"status":"validated","expiration":null,"rules":` + allRules + `}`,
+		},
+		"expiring soon": {
+			body:     `{"status":"validated","expiration":"2026-01-03T00:00:00Z","rules":` + allRules + `}`,
+			warnings: []string{"OVH Consumer Key Expires Soon"},
+		},
+		"expired": {
+			body:   `{"status":"validated","expiration":"2025-12-31T00:00:00Z","rules":` + allRules + `}`,
+			errors: []string{"Expired OVH Consumer Key"},
+		},
+		"pending validation": {
+			body:   `{"status":"pendingValidation","rules":` + allRules + `}`,
+			errors: []string{"Invalid OVH Consumer Key"},
+		},
+		"missing rules": {
+			body:     `{"status":"validated","rules":[{"method":"GET","path":"/cloud/project/p1/*"}]}`,
+			warnings: []string{"Incomplete OVH Access Rules"},
+		},
+		"rejected": {
+			status: http.StatusForbidden,
+			body:   `{"message":"This credential does not exist"}`,
+			errors: []string{"Invalid OVH Credentials"},
+		},
+		"unavailable": {
+			status: http.StatusServiceUnavailable,
+			body:   `{"message":"Service unavailable"}`,
+			errors: []string{"Unable to Validate OVH Credentials"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/auth/time", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, time.Now().Unix())
+			})
+			mux.HandleFunc("/auth/currentCredential", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if tt.status != 0 {
+					w.WriteHeader(tt.status)
+				}
+				io.WriteString(w, tt.body)
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			ovhClient, err := ovh.NewClient(server.URL, "ak", "as", "ck")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			diags := validateOVHCredential(context.Background(), client.New(ovhClient), "p1", now)
+
+			var errs, warnings []string
+			for _, d := range diags.Errors() {
+				errs = append(errs, d.Summary())
+			}
+			for _, d := range diags.Warnings() {
+				warnings = append(warnings, d.Summary())
+			}
+			if !slices.Equal(errs, tt.errors) || !slices.Equal(warnings, tt.warnings) {
+				t.Errorf("got errors %v and warnings %v, want %v and %v", errs, warnings, tt.errors, tt.warnings)
+			}
+		})
+	}
+}
@@ -103,7 +103,7 @@ func dataSourceDatabricksWorkspacesRead(ctx context.Context, d *schema.ResourceD
 	_ = diag.Diagnostics{}
 
 	var workspaces []map[string]interface{}
-	err := config.OVHClient.Get("/cloud/project/databricks/workspace", &workspaces)
+	err := config.OVHClient.GetWithContext(ctx, "/cloud/project/databricks/workspace", &workspaces)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to read Databricks workspaces: %w", err))
 	}
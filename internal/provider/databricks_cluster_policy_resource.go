@@ -4,17 +4,23 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
 )
 
 var _ resource.Resource = &DatabricksClusterPolicyResource{}
 var _ resource.ResourceWithImportState = &DatabricksClusterPolicyResource{}
+var _ resource.ResourceWithValidateConfig = &DatabricksClusterPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &DatabricksClusterPolicyResource{}
 
 func NewDatabricksClusterPolicyResource() resource.Resource {
 	return &DatabricksClusterPolicyResource{}
@@ -25,12 +31,19 @@ type DatabricksClusterPolicyResource struct {
 }
 
 type DatabricksClusterPolicyResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	WorkspaceID types.String `tfsdk:"workspace_id"`
-	Name        types.String `tfsdk:"name"`
-	Definition  types.String `tfsdk:"definition"`
-	PolicyID    types.String `tfsdk:"policy_id"`
-	CreatedTime types.String `tfsdk:"created_time"`
+	ID                              types.String      `tfsdk:"id"`
+	ProjectID                       types.String      `tfsdk:"project_id"`
+	WorkspaceID                     types.String      `tfsdk:"workspace_id"`
+	Name                            types.String      `tfsdk:"name"`
+	Definition                      policyDefinition  `tfsdk:"definition"`
+	Rules                           []PolicyRuleModel `tfsdk:"rule"`
+	Description                     types.String      `tfsdk:"description"`
+	MaxClustersPerUser              types.Int64       `tfsdk:"max_clusters_per_user"`
+	PolicyFamilyID                  types.String      `tfsdk:"policy_family_id"`
+	PolicyFamilyDefinitionOverrides policyDefinition  `tfsdk:"policy_family_definition_overrides"`
+	Libraries                       []LibraryModel    `tfsdk:"libraries"`
+	PolicyID                        types.String      `tfsdk:"policy_id"`
+	CreatedTime                     types.String      `tfsdk:"created_time"`
 }
 
 func (r *DatabricksClusterPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -48,6 +61,15 @@ func (r *DatabricksClusterPolicyResource) Schema(ctx context.Context, req resour
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"project_id": schema.StringAttribute{
+				Description: "OVH Public Cloud project ID. Defaults to the provider ovh_project_id",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"workspace_id": schema.StringAttribute{
 				Description: "Workspace ID",
 				Required:    true,
@@ -60,8 +82,41 @@ func (r *DatabricksClusterPolicyResource) Schema(ctx context.Context, req resour
 				Required:    true,
 			},
 			"definition": schema.StringAttribute{
-				Description: "Policy definition JSON",
-				Required:    true,
+				Description: "Policy definition JSON. Differences in formatting and key order with the definition returned by the API are ignored. " +
+					"Computed from the rule blocks, or from the policy family and its overrides, when they are used instead",
+				Optional:   true,
+				Computed:   true,
+				CustomType: policyDefinitionType{},
+				Validators: []validator.String{
+					policyDefinitionValidator{},
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Policy description",
+				Optional:    true,
+			},
+			"max_clusters_per_user": schema.Int64Attribute{
+				Description: "Maximum number of clusters a user can create with the policy",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"policy_family_id": schema.StringAttribute{
+				Description: "ID of the policy family the policy is created from, such as the policy_family_id of a databricks-ovh_policy_families entry. " +
+					"The definition is then the definition of the family with policy_family_definition_overrides applied. Changing it recreates the policy",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_family_definition_overrides": schema.StringAttribute{
+				Description: "Policy definition JSON whose rules replace those of the policy family. Differences in formatting and key order are ignored",
+				Optional:    true,
+				CustomType:  policyDefinitionType{},
+				Validators: []validator.String{
+					policyDefinitionValidator{},
+				},
 			},
 			"policy_id": schema.StringAttribute{
 				Description: "Databricks policy ID",
@@ -72,6 +127,99 @@ func (r *DatabricksClusterPolicyResource) Schema(ctx context.Context, req resour
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"rule":      policyRuleBlock(),
+			"libraries": librariesBlock(),
+		},
+	}
+}
+
+func (r *DatabricksClusterPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var definition, overrides policyDefinition
+	var familyID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("definition"), &definition)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("policy_family_id"), &familyID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("policy_family_definition_overrides"), &overrides)...)
+	rules, known := policyRules(ctx, req.Config.GetAttribute, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case !familyID.IsNull() && !familyID.IsUnknown() && (!definition.IsNull() || len(rules) > 0 || !known):
+		resp.Diagnostics.AddAttributeError(
+			path.Root("definition"),
+			"Conflicting Policy Definition",
+			"definition and rule cannot be set together with policy_family_id. Use policy_family_definition_overrides to change the rules of the family.",
+		)
+	case !definition.IsNull() && (len(rules) > 0 || !known):
+		resp.Diagnostics.AddAttributeError(
+			path.Root("definition"),
+			"Conflicting Policy Definition",
+			"Only one of definition and rule can be set.",
+		)
+	case definition.IsNull() && len(rules) == 0 && known && familyID.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("definition"),
+			"Missing Policy Definition",
+			"One of definition, rule and policy_family_id must be set.",
+		)
+	}
+
+	if !overrides.IsNull() && familyID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("policy_family_definition_overrides"),
+			"Missing Policy Family",
+			"policy_family_definition_overrides can only be set together with policy_family_id.",
+		)
+	}
+
+	validatePolicyRules(ctx, rules, &resp.Diagnostics)
+}
+
+// ModifyPlan compiles the rule blocks into the planned definition, so that
+// it is known before apply. The definition of a policy created from a
+// policy family is kept until the family or its overrides change.
+func (r *DatabricksClusterPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	rules, known := policyRules(ctx, req.Plan.GetAttribute, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || !known {
+		return
+	}
+
+	if len(rules) > 0 {
+		definition, ok := compilePolicyRules(ctx, rules, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("definition"), newPolicyDefinitionValue(definition))...)
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state struct {
+		familyID   types.String
+		overrides  policyDefinition
+		definition policyDefinition
+	}
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("policy_family_id"), &plan.familyID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("policy_family_definition_overrides"), &plan.overrides)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("definition"), &plan.definition)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("policy_family_id"), &state.familyID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("policy_family_definition_overrides"), &state.overrides)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("definition"), &state.definition)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.familyID.IsNull() && plan.definition.IsUnknown() && plan.familyID.Equal(state.familyID) && plan.overrides.Equal(state.overrides) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("definition"), state.definition)...)
 	}
 }
 
@@ -100,30 +248,36 @@ func (r *DatabricksClusterPolicyResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ProjectID = types.StringValue(projectID)
+
 	tflog.Trace(ctx, "creating databricks cluster policy resource")
 
-	policyConfig := map[string]interface{}{
-		"workspaceId": data.WorkspaceID.ValueString(),
-		"name":        data.Name.ValueString(),
-		"definition":  data.Definition.ValueString(),
+	libraries := expandLibraries(ctx, data.Libraries, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	var result map[string]interface{}
-	err := r.client.OVHClient.Post("/cloud/project/databricks/cluster-policy", policyConfig, &result)
+	policy, err := r.client.API.Project(projectID).CreateClusterPolicy(ctx, &client.ClusterPolicyCreateRequest{
+		WorkspaceID:                     data.WorkspaceID.ValueString(),
+		Name:                            data.Name.ValueString(),
+		Definition:                      data.definition(),
+		Description:                     data.Description.ValueString(),
+		MaxClustersPerUser:              data.MaxClustersPerUser.ValueInt64Pointer(),
+		PolicyFamilyID:                  data.PolicyFamilyID.ValueString(),
+		PolicyFamilyDefinitionOverrides: data.PolicyFamilyDefinitionOverrides.ValueString(),
+		Libraries:                       libraries,
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create cluster policy, got error: %s", err))
+		addClientError(&resp.Diagnostics, "create cluster policy", err)
 		return
 	}
 
-	policyId := result["id"].(string)
-	data.ID = types.StringValue(policyId)
-
-	if databricksPolicyId, ok := result["policyId"].(string); ok {
-		data.PolicyID = types.StringValue(databricksPolicyId)
-	}
-	if createdTime, ok := result["createdTime"].(string); ok {
-		data.CreatedTime = types.StringValue(createdTime)
-	}
+	data.refresh(ctx, policy, &resp.Diagnostics)
 
 	tflog.Trace(ctx, "created databricks cluster policy resource")
 
@@ -138,29 +292,26 @@ func (r *DatabricksClusterPolicyResource) Read(ctx context.Context, req resource
 		return
 	}
 
-	var policy map[string]interface{}
-	err := r.client.OVHClient.Get(fmt.Sprintf("/cloud/project/databricks/cluster-policy/%s", data.ID.ValueString()), &policy)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster policy, got error: %s", err))
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	data.ProjectID = types.StringValue(projectID)
 
-	if workspaceId, ok := policy["workspaceId"].(string); ok {
-		data.WorkspaceID = types.StringValue(workspaceId)
-	}
-	if name, ok := policy["name"].(string); ok {
-		data.Name = types.StringValue(name)
-	}
-	if definition, ok := policy["definition"].(string); ok {
-		data.Definition = types.StringValue(definition)
-	}
-	if policyId, ok := policy["policyId"].(string); ok {
-		data.PolicyID = types.StringValue(policyId)
+	policy, err := r.client.API.Project(projectID).GetClusterPolicy(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "databricks cluster policy not found, removing from state", map[string]any{"id": data.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
 	}
-	if createdTime, ok := policy["createdTime"].(string); ok {
-		data.CreatedTime = types.StringValue(createdTime)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read cluster policy", err)
+		return
 	}
 
+	data.refresh(ctx, policy, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -172,17 +323,45 @@ func (r *DatabricksClusterPolicyResource) Update(ctx context.Context, req resour
 		return
 	}
 
-	updateConfig := map[string]interface{}{
-		"name":       data.Name.ValueString(),
-		"definition": data.Definition.ValueString(),
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	data.ProjectID = types.StringValue(projectID)
 
-	err := r.client.OVHClient.Put(fmt.Sprintf("/cloud/project/databricks/cluster-policy/%s", data.ID.ValueString()), updateConfig, nil)
+	api := r.client.API.Project(projectID)
+
+	libraries := expandLibraries(ctx, data.Libraries, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// An empty list removes the libraries of the policy.
+	if libraries == nil {
+		libraries = []client.Library{}
+	}
+
+	err := api.UpdateClusterPolicy(ctx, data.ID.ValueString(), &client.ClusterPolicyUpdateRequest{
+		Name:                            data.Name.ValueString(),
+		Definition:                      data.definition(),
+		Description:                     data.Description.ValueString(),
+		MaxClustersPerUser:              data.MaxClustersPerUser.ValueInt64Pointer(),
+		PolicyFamilyDefinitionOverrides: data.overrides(),
+		Libraries:                       libraries,
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cluster policy, got error: %s", err))
+		addClientError(&resp.Diagnostics, "update cluster policy", err)
 		return
 	}
 
+	policy, err := api.GetClusterPolicy(ctx, data.ID.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read cluster policy", err)
+		return
+	}
+
+	data.refresh(ctx, policy, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -194,13 +373,86 @@ func (r *DatabricksClusterPolicyResource) Delete(ctx context.Context, req resour
 		return
 	}
 
-	err := r.client.OVHClient.Delete(fmt.Sprintf("/cloud/project/databricks/cluster-policy/%s", data.ID.ValueString()), nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete cluster policy, got error: %s", err))
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.API.Project(projectID).DeleteClusterPolicy(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "delete cluster policy", err)
 		return
 	}
 }
 
 func (r *DatabricksClusterPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateWithProject(ctx, req, resp)
+}
+
+// definition returns the definition sent to the API. Policies created from a
+// policy family only send their overrides.
+func (data *DatabricksClusterPolicyResourceModel) definition() string {
+	if !data.PolicyFamilyID.IsNull() {
+		return ""
+	}
+	return data.Definition.ValueString()
+}
+
+// overrides returns the family overrides sent when updating the policy.
+// Policies created from a policy family send an empty object when the
+// overrides are removed, since leaving them out keeps the previous ones.
+func (data *DatabricksClusterPolicyResourceModel) overrides() string {
+	switch {
+	case data.PolicyFamilyID.IsNull():
+		return ""
+	case data.PolicyFamilyDefinitionOverrides.IsNull():
+		return "{}"
+	}
+	return data.PolicyFamilyDefinitionOverrides.ValueString()
+}
+
+// refresh copies the API representation of a cluster policy into the model.
+func (data *DatabricksClusterPolicyResourceModel) refresh(ctx context.Context, policy *client.ClusterPolicy, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(policy.ID.String())
+
+	if policy.WorkspaceID != "" {
+		data.WorkspaceID = types.StringValue(policy.WorkspaceID)
+	}
+	if policy.Name != "" {
+		data.Name = types.StringValue(policy.Name)
+	}
+	if policy.Definition != "" {
+		data.Definition = newPolicyDefinitionValue(policy.Definition)
+	}
+
+	data.Description = stringValueOrNull(policy.Description)
+	data.MaxClustersPerUser = types.Int64PointerValue(policy.MaxClustersPerUser)
+	data.PolicyFamilyID = stringValueOrNull(policy.PolicyFamilyID)
+	// Removed overrides are returned as an empty object, which is kept only
+	// when it is what the configuration sets.
+	overrides := policy.PolicyFamilyDefinitionOverrides
+	if overrides == "" || (data.PolicyFamilyDefinitionOverrides.IsNull() && emptyPolicyDefinition(overrides)) {
+		data.PolicyFamilyDefinitionOverrides = newPolicyDefinitionNull()
+	} else {
+		data.PolicyFamilyDefinitionOverrides = newPolicyDefinitionValue(overrides)
+	}
+	data.Libraries = flattenLibraries(ctx, policy.Libraries, diags)
+
+	data.PolicyID = types.StringValue(policy.PolicyID.String())
+	data.CreatedTime = types.StringValue(policy.CreatedTime.String())
+}
+
+// policyRules returns the rule blocks of a configuration or plan. It returns
+// false when they are unknown, as with a dynamic block over an unknown value.
+func policyRules(ctx context.Context, get func(context.Context, path.Path, any) diag.Diagnostics, diags *diag.Diagnostics) ([]PolicyRuleModel, bool) {
+	var list types.List
+	diags.Append(get(ctx, path.Root("rule"), &list)...)
+	if diags.HasError() || list.IsUnknown() {
+		return nil, false
+	}
+
+	var rules []PolicyRuleModel
+	diags.Append(list.ElementsAs(ctx, &rules, false)...)
+	return rules, true
 }
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+)
+
+func TestClusterPolicyClearOverrides(t *testing.T) {
+	ctx := context.Background()
+	const overrides = `{"autotermination_minutes": {"type": "fixed", "value": 30}}`
+
+	data := DatabricksClusterPolicyResourceModel{
+		PolicyFamilyID:                  types.StringValue("personal-vm"),
+		PolicyFamilyDefinitionOverrides: newPolicyDefinitionValue(overrides),
+	}
+	if got := data.overrides(); got != overrides {
+		t.Errorf("got overrides %q, want %q", got, overrides)
+	}
+
+	// Removing the overrides from the configuration must clear them.
+	data.PolicyFamilyDefinitionOverrides = newPolicyDefinitionNull()
+	if got := data.overrides(); got != "{}" {
+		t.Errorf("got overrides %q for removed overrides, want {}", got)
+	}
+
+	var diags diag.Diagnostics
+	data.refresh(ctx, &client.ClusterPolicy{PolicyFamilyID: "personal-vm", PolicyFamilyDefinitionOverrides: "{ }"}, &diags)
+	if !data.PolicyFamilyDefinitionOverrides.IsNull() {
+		t.Errorf("expected cleared overrides to be null, got %s", data.PolicyFamilyDefinitionOverrides)
+	}
+
+	data.PolicyFamilyDefinitionOverrides = newPolicyDefinitionValue("{}")
+	data.refresh(ctx, &client.ClusterPolicy{PolicyFamilyID: "personal-vm", PolicyFamilyDefinitionOverrides: "{}"}, &diags)
+	if data.PolicyFamilyDefinitionOverrides.ValueString() != "{}" {
+		t.Errorf("expected configured empty overrides to be kept, got %s", data.PolicyFamilyDefinitionOverrides)
+	}
+
+	data.PolicyFamilyID = types.StringNull()
+	if got := data.overrides(); got != "" {
+		t.Errorf("got overrides %q for a policy without family, want none", got)
+	}
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+}
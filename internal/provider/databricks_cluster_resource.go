@@ -0,0 +1,615 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+)
+
+const (
+	clusterCreateTimeout = 30 * time.Minute
+	clusterReadTimeout   = 5 * time.Minute
+	clusterUpdateTimeout = 30 * time.Minute
+	clusterDeleteTimeout = 20 * time.Minute
+)
+
+var _ resource.Resource = &DatabricksClusterResource{}
+var _ resource.ResourceWithImportState = &DatabricksClusterResource{}
+var _ resource.ResourceWithValidateConfig = &DatabricksClusterResource{}
+var _ resource.ResourceWithModifyPlan = &DatabricksClusterResource{}
+
+func NewDatabricksClusterResource() resource.Resource {
+	return &DatabricksClusterResource{}
+}
+
+type DatabricksClusterResource struct {
+	client *Config
+}
+
+type DatabricksClusterResourceModel struct {
+	ID                     types.String     `tfsdk:"id"`
+	ProjectID              types.String     `tfsdk:"project_id"`
+	WorkspaceURL           types.String     `tfsdk:"workspace_url"`
+	WorkspaceID            types.String     `tfsdk:"workspace_id"`
+	ClusterName            types.String     `tfsdk:"cluster_name"`
+	SparkVersion           types.String     `tfsdk:"spark_version"`
+	NodeTypeID             types.String     `tfsdk:"node_type_id"`
+	DriverNodeTypeID       types.String     `tfsdk:"driver_node_type_id"`
+	NumWorkers             types.Int64      `tfsdk:"num_workers"`
+	Autoscale              []AutoScaleModel `tfsdk:"autoscale"`
+	AutoterminationMinutes types.Int64      `tfsdk:"autotermination_minutes"`
+	SparkConf              types.Map        `tfsdk:"spark_conf"`
+	CustomTags             types.Map        `tfsdk:"custom_tags"`
+	PolicyID               types.String     `tfsdk:"policy_id"`
+	ClusterID              types.String     `tfsdk:"cluster_id"`
+	State                  types.String     `tfsdk:"state"`
+	CreatedTime            types.String     `tfsdk:"created_time"`
+	Timeouts               timeouts.Value   `tfsdk:"timeouts"`
+}
+
+func (r *DatabricksClusterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster"
+}
+
+func (r *DatabricksClusterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Databricks all-purpose cluster on OVH infrastructure",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Cluster identifier",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "OVH Public Cloud project ID. Defaults to the provider ovh_project_id",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "Workspace ID",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace_url": workspaceURLAttribute(),
+			"cluster_name": schema.StringAttribute{
+				Description: "Cluster name",
+				Optional:    true,
+			},
+			"spark_version": schema.StringAttribute{
+				Description: "Spark version. Changing it restarts the cluster",
+				Required:    true,
+			},
+			"node_type_id": schema.StringAttribute{
+				Description: "Worker node type ID. Changing it restarts the cluster",
+				Required:    true,
+			},
+			"driver_node_type_id": schema.StringAttribute{
+				Description: "Driver node type ID. Defaults to node_type_id. Changing it restarts the cluster",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"num_workers": schema.Int64Attribute{
+				Description: "Number of workers of a fixed-size cluster. Changing it resizes the cluster in place",
+				Optional:    true,
+			},
+			"autotermination_minutes": schema.Int64Attribute{
+				Description: "Minutes of inactivity after which the cluster is terminated",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"spark_conf": schema.MapAttribute{
+				Description: "Spark configuration. Changing it restarts the cluster",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"custom_tags": schema.MapAttribute{
+				Description: "Custom tags",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"policy_id": schema.StringAttribute{
+				Description: "Databricks ID of the cluster policy the cluster must comply with, usually the policy_id of a databricks-ovh_cluster_policy. " +
+					"When the policy already exists, the cluster is checked against it and given its default values at plan time. " +
+					"A policy created in the same apply is only checked by Databricks when the cluster is created",
+				Optional: true,
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "Databricks cluster ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "Cluster state",
+				Computed:    true,
+			},
+			"created_time": schema.StringAttribute{
+				Description: "Creation timestamp",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"autoscale": autoScaleBlock(),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *DatabricksClusterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var numWorkers types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("num_workers"), &numWorkers)...)
+	autoscale := configuredBlocks(ctx, req.Config, path.Empty(), []string{"autoscale"}, &resp.Diagnostics)
+
+	if !numWorkers.IsNull() && len(autoscale) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("num_workers"),
+			"Conflicting Cluster Size",
+			"Only one of num_workers and autoscale can be set.",
+		)
+	}
+}
+
+// ModifyPlan checks the planned cluster against its cluster policy, when the
+// policy already exists, and plans the policy defaults of the attributes
+// left unknown. A policy created in the same plan is only checked during
+// apply, which is reported as a warning.
+func (r *DatabricksClusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) || r.client == nil {
+		return
+	}
+
+	var data DatabricksClusterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.PolicyID.IsNull() || data.WorkspaceURL.IsUnknown() {
+		return
+	}
+	if data.PolicyID.IsUnknown() {
+		addUnknownPolicyWarning(&resp.Diagnostics, path.Root("policy_id"))
+		return
+	}
+
+	var projectID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+	if resp.Diagnostics.HasError() || projectID.IsUnknown() {
+		return
+	}
+
+	// Missing credentials are reported when the cluster is applied.
+	api, diags := r.api(&data)
+	if diags.HasError() {
+		return
+	}
+
+	policy := fetchClusterPolicy(ctx, api, data.PolicyID.ValueString(), path.Root("policy_id"), &resp.Diagnostics)
+	if policy == nil {
+		return
+	}
+
+	if data.DriverNodeTypeID.IsUnknown() {
+		if v, ok := policy.defaultValue("driver_node_type_id"); ok {
+			data.DriverNodeTypeID = types.StringValue(v)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("driver_node_type_id"), data.DriverNodeTypeID)...)
+		}
+	}
+	if data.AutoterminationMinutes.IsUnknown() {
+		if v, ok := policy.defaultValue("autotermination_minutes"); ok {
+			if minutes, err := strconv.ParseInt(v, 10, 64); err == nil {
+				data.AutoterminationMinutes = types.Int64Value(minutes)
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("autotermination_minutes"), data.AutoterminationMinutes)...)
+			}
+		}
+	}
+
+	policy.evaluate(data.policyAttributes(), &resp.Diagnostics)
+}
+
+func (r *DatabricksClusterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DatabricksClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatabricksClusterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := r.api(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings := data.expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, clusterCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	tflog.Trace(ctx, "creating databricks cluster resource")
+
+	cluster, err := api.CreateCluster(ctx, &client.ClusterCreateRequest{
+		ClusterSettings: settings,
+		WorkspaceID:     data.WorkspaceID.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "create cluster", err)
+		return
+	}
+
+	data.refresh(ctx, cluster, &resp.Diagnostics)
+
+	// Save the identifier before waiting so that a cluster which fails to
+	// start is tracked (and tainted) rather than orphaned.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "waiting for databricks cluster to be running", map[string]any{"id": data.ID.ValueString()})
+
+	cluster, err = api.WaitForClusterRunning(ctx, data.ID.ValueString())
+	if cluster != nil {
+		data.refresh(ctx, cluster, &resp.Diagnostics)
+	}
+	if err != nil {
+		if !addContextError(&resp.Diagnostics, "start cluster "+data.ID.ValueString(), err) {
+			resp.Diagnostics.AddError("Cluster Start Error", fmt.Sprintf("Cluster %s did not become RUNNING: %s", data.ID.ValueString(), err))
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	tflog.Trace(ctx, "created databricks cluster resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabricksClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatabricksClusterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := r.api(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, clusterReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	cluster, err := api.GetCluster(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "databricks cluster not found, removing from state", map[string]any{"id": data.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read cluster", err)
+		return
+	}
+
+	// The maps of an imported cluster are all kept, as if configured.
+	if readingImport(ctx, req, resp) {
+		data.SparkConf = stringsToMap(ctx, cluster.SparkConf, &resp.Diagnostics)
+		data.CustomTags = stringsToMap(ctx, cluster.CustomTags, &resp.Diagnostics)
+	}
+
+	data.refresh(ctx, cluster, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update resizes a running cluster in place when only its size changes.
+// Other changes are applied with an edit, which restarts a running cluster
+// by itself, so Update only waits for it to return to RUNNING. Terminated
+// clusters are edited and left terminated.
+func (r *DatabricksClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DatabricksClusterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := r.api(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings := data.expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, clusterUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	id := data.ID.ValueString()
+
+	running := state.State.ValueString() == client.ClusterStateRunning
+	resized := !data.NumWorkers.Equal(state.NumWorkers) || !autoScaleEqual(data.Autoscale, state.Autoscale)
+	edited := !data.SparkVersion.Equal(state.SparkVersion) ||
+		!data.NodeTypeID.Equal(state.NodeTypeID) ||
+		!(data.DriverNodeTypeID.IsUnknown() || data.DriverNodeTypeID.Equal(state.DriverNodeTypeID)) ||
+		!data.SparkConf.Equal(state.SparkConf) ||
+		!data.ClusterName.Equal(state.ClusterName) ||
+		!data.AutoterminationMinutes.Equal(state.AutoterminationMinutes) ||
+		!data.CustomTags.Equal(state.CustomTags) ||
+		!data.PolicyID.Equal(state.PolicyID)
+
+	if running && resized && !edited {
+		tflog.Debug(ctx, "resizing databricks cluster", map[string]any{"id": id})
+
+		err := api.ResizeCluster(ctx, id, &client.ClusterResizeRequest{
+			NumWorkers: settings.NumWorkers,
+			Autoscale:  settings.Autoscale,
+		})
+		if err != nil {
+			addClientError(&resp.Diagnostics, "resize cluster", err)
+			return
+		}
+	} else {
+		err := api.UpdateCluster(ctx, id, &client.ClusterUpdateRequest{ClusterSettings: settings})
+		if err != nil {
+			addClientError(&resp.Diagnostics, "update cluster", err)
+			return
+		}
+	}
+
+	var cluster *client.Cluster
+	var err error
+	if running {
+		cluster, err = api.WaitForClusterRunning(ctx, id)
+		if err != nil {
+			if !addContextError(&resp.Diagnostics, "update cluster "+id, err) {
+				resp.Diagnostics.AddError("Cluster Update Error", fmt.Sprintf("Cluster %s did not return to RUNNING: %s", id, err))
+			}
+			// The edit itself succeeded, so save the cluster as the API
+			// last returned it rather than the prior state.
+			if cluster == nil {
+				cluster, _ = api.GetCluster(context.WithoutCancel(ctx), id)
+			}
+			if cluster != nil {
+				data.refresh(ctx, cluster, &resp.Diagnostics)
+				resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			}
+			return
+		}
+	} else {
+		cluster, err = api.GetCluster(ctx, id)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "read cluster", err)
+			return
+		}
+	}
+
+	data.refresh(ctx, cluster, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabricksClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DatabricksClusterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := r.api(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, clusterDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := api.DeleteCluster(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "terminate cluster", err)
+		return
+	}
+
+	if err := api.WaitForClusterTerminated(ctx, data.ID.ValueString()); err != nil {
+		if !addContextError(&resp.Diagnostics, "terminate cluster "+data.ID.ValueString(), err) {
+			resp.Diagnostics.AddError("Cluster Termination Error", fmt.Sprintf("Cluster %s was not terminated: %s", data.ID.ValueString(), err))
+		}
+		return
+	}
+}
+
+func (r *DatabricksClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateWithWorkspaceURL(ctx, req, resp, importStateWithProject, importStateInWorkspace)
+	markImported(ctx, resp)
+}
+
+// expand builds the cluster settings sent to the API from the model.
+func (data *DatabricksClusterResourceModel) expand(ctx context.Context, diags *diag.Diagnostics) client.ClusterSettings {
+	settings := client.ClusterSettings{
+		ClusterName:            data.ClusterName.ValueString(),
+		SparkVersion:           data.SparkVersion.ValueString(),
+		NodeTypeID:             data.NodeTypeID.ValueString(),
+		NumWorkers:             data.NumWorkers.ValueInt64Pointer(),
+		Autoscale:              expandAutoScale(data.Autoscale),
+		AutoterminationMinutes: data.AutoterminationMinutes.ValueInt64Pointer(),
+		SparkConf:              mapToStrings(ctx, data.SparkConf, diags),
+		CustomTags:             mapToStrings(ctx, data.CustomTags, diags),
+		PolicyID:               data.PolicyID.ValueString(),
+	}
+	if !data.DriverNodeTypeID.IsUnknown() {
+		settings.DriverNodeTypeID = data.DriverNodeTypeID.ValueString()
+	}
+	return settings
+}
+
+// refresh copies the API representation of a cluster into the model. The
+// current worker count of an autoscaling cluster is not copied, since it is
+// driven by the load rather than the configuration. Only the spark_conf and
+// custom_tags entries and the policy_id already in the model are copied, as
+// the workspace and its cluster policies can add their own.
+func (data *DatabricksClusterResourceModel) refresh(ctx context.Context, cluster *client.Cluster, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(cluster.ID.String())
+
+	if cluster.WorkspaceID != "" {
+		data.WorkspaceID = types.StringValue(cluster.WorkspaceID)
+	}
+	if cluster.ClusterName != "" {
+		data.ClusterName = types.StringValue(cluster.ClusterName)
+	}
+	if cluster.SparkVersion != "" {
+		data.SparkVersion = types.StringValue(cluster.SparkVersion)
+	}
+	if cluster.NodeTypeID != "" {
+		data.NodeTypeID = types.StringValue(cluster.NodeTypeID)
+	}
+	if cluster.DriverNodeTypeID != "" || data.DriverNodeTypeID.IsUnknown() {
+		data.DriverNodeTypeID = types.StringValue(cluster.DriverNodeTypeID)
+	}
+
+	data.Autoscale = flattenAutoScale(cluster.Autoscale)
+	switch {
+	case cluster.Autoscale != nil:
+		data.NumWorkers = types.Int64Null()
+	case cluster.NumWorkers != nil && (*cluster.NumWorkers != 0 || !data.NumWorkers.IsNull()):
+		data.NumWorkers = types.Int64Value(*cluster.NumWorkers)
+	}
+
+	if cluster.AutoterminationMinutes != nil {
+		data.AutoterminationMinutes = types.Int64Value(*cluster.AutoterminationMinutes)
+	} else if data.AutoterminationMinutes.IsUnknown() {
+		data.AutoterminationMinutes = types.Int64Value(0)
+	}
+	data.SparkConf = refreshStringMap(ctx, data.SparkConf, cluster.SparkConf, diags)
+	data.CustomTags = refreshStringMap(ctx, data.CustomTags, cluster.CustomTags, diags)
+	if cluster.PolicyID != "" && !data.PolicyID.IsNull() {
+		data.PolicyID = types.StringValue(cluster.PolicyID)
+	}
+
+	data.ClusterID = types.StringValue(cluster.ClusterID.String())
+	data.State = types.StringValue(cluster.State)
+	data.CreatedTime = types.StringValue(cluster.CreatedTime.String())
+}
+
+// policyAttributes returns the attributes of the cluster that its policy
+// can restrict.
+func (data *DatabricksClusterResourceModel) policyAttributes() []policyAttribute {
+	attrs := []policyAttribute{
+		{name: "cluster_name", path: path.Root("cluster_name"), value: data.ClusterName},
+		{name: "spark_version", path: path.Root("spark_version"), value: data.SparkVersion},
+		{name: "node_type_id", path: path.Root("node_type_id"), value: data.NodeTypeID},
+		{name: "driver_node_type_id", path: path.Root("driver_node_type_id"), value: data.DriverNodeTypeID},
+		{name: "num_workers", path: path.Root("num_workers"), value: data.NumWorkers},
+		{name: "autotermination_minutes", path: path.Root("autotermination_minutes"), value: data.AutoterminationMinutes},
+	}
+	attrs = append(attrs, autoScalePolicyAttributes(data.Autoscale, path.Empty())...)
+	attrs = append(attrs, mapPolicyAttributes("spark_conf", data.SparkConf, path.Root("spark_conf"))...)
+	return append(attrs, mapPolicyAttributes("custom_tags", data.CustomTags, path.Root("custom_tags"))...)
+}
+
+func autoScaleEqual(a, b []AutoScaleModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].MinWorkers.Equal(b[i].MinWorkers) || !a[i].MaxWorkers.Equal(b[i].MaxWorkers) {
+			return false
+		}
+	}
+	return true
+}
+
+// api returns the client managing the cluster: the workspace API when
+// workspace_url is set, otherwise the OVH API of the project.
+func (r *DatabricksClusterResource) api(data *DatabricksClusterResourceModel) (clusterAPI, diag.Diagnostics) {
+	if !data.WorkspaceURL.IsNull() {
+		return r.client.workspaceAPI(data.WorkspaceURL, &data.ProjectID)
+	}
+	return r.client.projectAPI(&data.ProjectID)
+}
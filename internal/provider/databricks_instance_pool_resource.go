@@ -4,13 +4,23 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
 )
 
 var _ resource.Resource = &DatabricksInstancePoolResource{}
@@ -25,15 +35,52 @@ type DatabricksInstancePoolResource struct {
 }
 
 type DatabricksInstancePoolResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	WorkspaceID      types.String `tfsdk:"workspace_id"`
-	Name             types.String `tfsdk:"name"`
-	NodeTypeID       types.String `tfsdk:"node_type_id"`
-	MinIdleInstances types.Int64  `tfsdk:"min_idle_instances"`
-	MaxCapacity      types.Int64  `tfsdk:"max_capacity"`
-	PoolID           types.String `tfsdk:"pool_id"`
-	Status           types.String `tfsdk:"status"`
-	CreatedTime      types.String `tfsdk:"created_time"`
+	ID                                 types.String         `tfsdk:"id"`
+	ProjectID                          types.String         `tfsdk:"project_id"`
+	WorkspaceID                        types.String         `tfsdk:"workspace_id"`
+	Name                               types.String         `tfsdk:"name"`
+	NodeTypeID                         types.String         `tfsdk:"node_type_id"`
+	MinIdleInstances                   types.Int64          `tfsdk:"min_idle_instances"`
+	MaxCapacity                        types.Int64          `tfsdk:"max_capacity"`
+	IdleInstanceAutoterminationMinutes types.Int64          `tfsdk:"idle_instance_autotermination_minutes"`
+	EnableElasticDisk                  types.Bool           `tfsdk:"enable_elastic_disk"`
+	DiskSpec                           []DiskSpecModel      `tfsdk:"disk_spec"`
+	PreloadedSparkVersions             types.List           `tfsdk:"preloaded_spark_versions"`
+	OVHAttributes                      []OVHAttributesModel `tfsdk:"ovh_attributes"`
+	CustomTags                         types.Map            `tfsdk:"custom_tags"`
+	DefaultTags                        types.Map            `tfsdk:"default_tags"`
+	Stats                              types.Object         `tfsdk:"stats"`
+	PoolID                             types.String         `tfsdk:"pool_id"`
+	Status                             types.String         `tfsdk:"status"`
+	CreatedTime                        types.String         `tfsdk:"created_time"`
+}
+
+// DiskSpecModel describes a disk_spec block.
+type DiskSpecModel struct {
+	DiskType  types.String `tfsdk:"disk_type"`
+	DiskCount types.Int64  `tfsdk:"disk_count"`
+	DiskSize  types.Int64  `tfsdk:"disk_size"`
+}
+
+// OVHAttributesModel describes an ovh_attributes block.
+type OVHAttributesModel struct {
+	BillingPeriod    types.String `tfsdk:"billing_period"`
+	AvailabilityZone types.String `tfsdk:"availability_zone"`
+}
+
+// InstancePoolStatsModel describes the stats attribute.
+type InstancePoolStatsModel struct {
+	UsedCount        types.Int64 `tfsdk:"used_count"`
+	IdleCount        types.Int64 `tfsdk:"idle_count"`
+	PendingUsedCount types.Int64 `tfsdk:"pending_used_count"`
+	PendingIdleCount types.Int64 `tfsdk:"pending_idle_count"`
+}
+
+var instancePoolStatsAttrTypes = map[string]attr.Type{
+	"used_count":         types.Int64Type,
+	"idle_count":         types.Int64Type,
+	"pending_used_count": types.Int64Type,
+	"pending_idle_count": types.Int64Type,
 }
 
 func (r *DatabricksInstancePoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,6 +98,15 @@ func (r *DatabricksInstancePoolResource) Schema(ctx context.Context, req resourc
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"project_id": schema.StringAttribute{
+				Description: "OVH Public Cloud project ID. Defaults to the provider ovh_project_id",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"workspace_id": schema.StringAttribute{
 				Description: "Workspace ID",
 				Required:    true,
@@ -74,6 +130,69 @@ func (r *DatabricksInstancePoolResource) Schema(ctx context.Context, req resourc
 				Description: "Maximum capacity",
 				Optional:    true,
 			},
+			"idle_instance_autotermination_minutes": schema.Int64Attribute{
+				Description: "Minutes after which instances above min_idle_instances are terminated when idle",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10000),
+				},
+			},
+			"enable_elastic_disk": schema.BoolAttribute{
+				Description: "Whether instances grow their disks when they run out of space. Changing it recreates the pool",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"preloaded_spark_versions": schema.ListAttribute{
+				Description: "Spark version preloaded on idle instances, so that clusters start faster. Changing it recreates the pool",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_tags": schema.MapAttribute{
+				Description: "Custom tags of the pool instances",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"default_tags": schema.MapAttribute{
+				Description: "Tags added by Databricks to the pool instances",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"stats": schema.SingleNestedAttribute{
+				Description: "Instance counts of the pool, refreshed on every read",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"used_count": schema.Int64Attribute{
+						Description: "Instances used by clusters",
+						Computed:    true,
+					},
+					"idle_count": schema.Int64Attribute{
+						Description: "Idle instances",
+						Computed:    true,
+					},
+					"pending_used_count": schema.Int64Attribute{
+						Description: "Instances being started for clusters",
+						Computed:    true,
+					},
+					"pending_idle_count": schema.Int64Attribute{
+						Description: "Idle instances being started",
+						Computed:    true,
+					},
+				},
+			},
 			"pool_id": schema.StringAttribute{
 				Description: "Databricks pool ID",
 				Computed:    true,
@@ -87,6 +206,65 @@ func (r *DatabricksInstancePoolResource) Schema(ctx context.Context, req resourc
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"disk_spec": schema.ListNestedBlock{
+				Description: "Disks attached to each instance. Changing it recreates the pool",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"disk_type": schema.StringAttribute{
+							Description: "Disk type",
+							Optional:    true,
+						},
+						"disk_count": schema.Int64Attribute{
+							Description: "Number of disks",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"disk_size": schema.Int64Attribute{
+							Description: "Size of each disk in GB",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"ovh_attributes": schema.ListNestedBlock{
+				Description: "OVH settings of the pool instances. Changing it recreates the pool",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"billing_period": schema.StringAttribute{
+							Description: "Billing period of the instances, HOURLY or MONTHLY. Monthly billing costs less for instances kept for most of the month",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(client.InstancePoolBillingHourly),
+							Validators: []validator.String{
+								stringvalidator.OneOf(client.InstancePoolBillingHourly, client.InstancePoolBillingMonthly),
+							},
+						},
+						"availability_zone": schema.StringAttribute{
+							Description: "Availability zone of the instances, such as eu-west-par-a, in a region with several zones",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -115,35 +293,43 @@ func (r *DatabricksInstancePoolResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ProjectID = types.StringValue(projectID)
+
 	tflog.Trace(ctx, "creating databricks instance pool resource")
 
-	poolConfig := map[string]interface{}{
-		"workspaceId":      data.WorkspaceID.ValueString(),
-		"name":             data.Name.ValueString(),
-		"nodeTypeId":       data.NodeTypeID.ValueString(),
-		"minIdleInstances": data.MinIdleInstances.ValueInt64(),
-		"maxCapacity":      data.MaxCapacity.ValueInt64(),
+	createReq := &client.InstancePoolCreateRequest{
+		WorkspaceID:            data.WorkspaceID.ValueString(),
+		Name:                   data.Name.ValueString(),
+		NodeTypeID:             data.NodeTypeID.ValueString(),
+		MinIdleInstances:       data.MinIdleInstances.ValueInt64(),
+		MaxCapacity:            data.MaxCapacity.ValueInt64(),
+		DiskSpec:               expandDiskSpec(data.DiskSpec),
+		PreloadedSparkVersions: listToStrings(ctx, data.PreloadedSparkVersions, &resp.Diagnostics),
+		OVHAttributes:          expandOVHAttributes(data.OVHAttributes),
+		CustomTags:             mapToStrings(ctx, data.CustomTags, &resp.Diagnostics),
+	}
+	if !data.IdleInstanceAutoterminationMinutes.IsUnknown() {
+		createReq.IdleInstanceAutoterminationMinutes = data.IdleInstanceAutoterminationMinutes.ValueInt64Pointer()
+	}
+	if !data.EnableElasticDisk.IsUnknown() {
+		createReq.EnableElasticDisk = data.EnableElasticDisk.ValueBoolPointer()
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	var result map[string]interface{}
-	err := r.client.OVHClient.Post("/cloud/project/databricks/instance-pool", poolConfig, &result)
+	pool, err := r.client.API.Project(projectID).CreateInstancePool(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create instance pool, got error: %s", err))
+		addClientError(&resp.Diagnostics, "create instance pool", err)
 		return
 	}
 
-	poolId := result["id"].(string)
-	data.ID = types.StringValue(poolId)
-
-	if databricksPoolId, ok := result["poolId"].(string); ok {
-		data.PoolID = types.StringValue(databricksPoolId)
-	}
-	if status, ok := result["status"].(string); ok {
-		data.Status = types.StringValue(status)
-	}
-	if createdTime, ok := result["createdTime"].(string); ok {
-		data.CreatedTime = types.StringValue(createdTime)
-	}
+	data.refresh(ctx, pool, &resp.Diagnostics)
 
 	tflog.Trace(ctx, "created databricks instance pool resource")
 
@@ -158,38 +344,26 @@ func (r *DatabricksInstancePoolResource) Read(ctx context.Context, req resource.
 		return
 	}
 
-	var pool map[string]interface{}
-	err := r.client.OVHClient.Get(fmt.Sprintf("/cloud/project/databricks/instance-pool/%s", data.ID.ValueString()), &pool)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read instance pool, got error: %s", err))
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	data.ProjectID = types.StringValue(projectID)
 
-	if workspaceId, ok := pool["workspaceId"].(string); ok {
-		data.WorkspaceID = types.StringValue(workspaceId)
-	}
-	if name, ok := pool["name"].(string); ok {
-		data.Name = types.StringValue(name)
-	}
-	if nodeTypeId, ok := pool["nodeTypeId"].(string); ok {
-		data.NodeTypeID = types.StringValue(nodeTypeId)
-	}
-	if minIdleInstances, ok := pool["minIdleInstances"].(float64); ok {
-		data.MinIdleInstances = types.Int64Value(int64(minIdleInstances))
-	}
-	if maxCapacity, ok := pool["maxCapacity"].(float64); ok {
-		data.MaxCapacity = types.Int64Value(int64(maxCapacity))
-	}
-	if poolId, ok := pool["poolId"].(string); ok {
-		data.PoolID = types.StringValue(poolId)
-	}
-	if status, ok := pool["status"].(string); ok {
-		data.Status = types.StringValue(status)
+	pool, err := r.client.API.Project(projectID).GetInstancePool(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "databricks instance pool not found, removing from state", map[string]any{"id": data.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
 	}
-	if createdTime, ok := pool["createdTime"].(string); ok {
-		data.CreatedTime = types.StringValue(createdTime)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read instance pool", err)
+		return
 	}
 
+	data.refresh(ctx, pool, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -201,19 +375,43 @@ func (r *DatabricksInstancePoolResource) Update(ctx context.Context, req resourc
 		return
 	}
 
-	updateConfig := map[string]interface{}{
-		"name":             data.Name.ValueString(),
-		"nodeTypeId":       data.NodeTypeID.ValueString(),
-		"minIdleInstances": data.MinIdleInstances.ValueInt64(),
-		"maxCapacity":      data.MaxCapacity.ValueInt64(),
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ProjectID = types.StringValue(projectID)
+
+	api := r.client.API.Project(projectID)
+
+	updateReq := &client.InstancePoolUpdateRequest{
+		Name:             data.Name.ValueString(),
+		NodeTypeID:       data.NodeTypeID.ValueString(),
+		MinIdleInstances: data.MinIdleInstances.ValueInt64(),
+		MaxCapacity:      data.MaxCapacity.ValueInt64(),
+		CustomTags:       mapToStrings(ctx, data.CustomTags, &resp.Diagnostics),
+	}
+	if !data.IdleInstanceAutoterminationMinutes.IsUnknown() {
+		updateReq.IdleInstanceAutoterminationMinutes = data.IdleInstanceAutoterminationMinutes.ValueInt64Pointer()
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := api.UpdateInstancePool(ctx, data.ID.ValueString(), updateReq)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "update instance pool", err)
+		return
 	}
 
-	err := r.client.OVHClient.Put(fmt.Sprintf("/cloud/project/databricks/instance-pool/%s", data.ID.ValueString()), updateConfig, nil)
+	pool, err := api.GetInstancePool(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update instance pool, got error: %s", err))
+		addClientError(&resp.Diagnostics, "read instance pool", err)
 		return
 	}
 
+	data.refresh(ctx, pool, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -225,13 +423,142 @@ func (r *DatabricksInstancePoolResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
-	err := r.client.OVHClient.Delete(fmt.Sprintf("/cloud/project/databricks/instance-pool/%s", data.ID.ValueString()), nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete instance pool, got error: %s", err))
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.API.Project(projectID).DeleteInstancePool(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "delete instance pool", err)
 		return
 	}
 }
 
 func (r *DatabricksInstancePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateWithProject(ctx, req, resp)
+}
+
+// refresh copies the API representation of an instance pool into the model.
+func (data *DatabricksInstancePoolResourceModel) refresh(ctx context.Context, pool *client.InstancePool, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(pool.ID.String())
+
+	if pool.WorkspaceID != "" {
+		data.WorkspaceID = types.StringValue(pool.WorkspaceID)
+	}
+	if pool.Name != "" {
+		data.Name = types.StringValue(pool.Name)
+	}
+	if pool.NodeTypeID != "" {
+		data.NodeTypeID = types.StringValue(pool.NodeTypeID)
+	}
+	if pool.MinIdleInstances != nil {
+		data.MinIdleInstances = types.Int64Value(*pool.MinIdleInstances)
+	}
+	if pool.MaxCapacity != nil {
+		data.MaxCapacity = types.Int64Value(*pool.MaxCapacity)
+	}
+
+	if pool.IdleInstanceAutoterminationMinutes != nil {
+		data.IdleInstanceAutoterminationMinutes = types.Int64Value(*pool.IdleInstanceAutoterminationMinutes)
+	} else if data.IdleInstanceAutoterminationMinutes.IsUnknown() {
+		data.IdleInstanceAutoterminationMinutes = types.Int64Null()
+	}
+	if pool.EnableElasticDisk != nil {
+		data.EnableElasticDisk = types.BoolValue(*pool.EnableElasticDisk)
+	} else if data.EnableElasticDisk.IsUnknown() {
+		data.EnableElasticDisk = types.BoolValue(false)
+	}
+	data.DiskSpec = flattenDiskSpec(pool.DiskSpec)
+	data.PreloadedSparkVersions = stringsToList(ctx, pool.PreloadedSparkVersions, diags)
+	data.OVHAttributes = flattenOVHAttributes(data.OVHAttributes, pool.OVHAttributes)
+	data.CustomTags = stringsToMap(ctx, pool.CustomTags, diags)
+	data.DefaultTags = stringsToMap(ctx, pool.DefaultTags, diags)
+	data.Stats = flattenInstancePoolStats(ctx, pool.Stats, diags)
+
+	data.PoolID = types.StringValue(pool.PoolID.String())
+	data.Status = types.StringValue(pool.Status)
+	data.CreatedTime = types.StringValue(pool.CreatedTime.String())
+}
+
+func expandDiskSpec(specs []DiskSpecModel) *client.DiskSpec {
+	if len(specs) == 0 {
+		return nil
+	}
+
+	return &client.DiskSpec{
+		DiskType:  specs[0].DiskType.ValueString(),
+		DiskCount: specs[0].DiskCount.ValueInt64(),
+		DiskSize:  specs[0].DiskSize.ValueInt64(),
+	}
+}
+
+func flattenDiskSpec(spec *client.DiskSpec) []DiskSpecModel {
+	if spec == nil {
+		return []DiskSpecModel{}
+	}
+
+	model := DiskSpecModel{
+		DiskType:  stringValueOrNull(spec.DiskType),
+		DiskCount: types.Int64Null(),
+		DiskSize:  types.Int64Null(),
+	}
+	if spec.DiskCount != 0 {
+		model.DiskCount = types.Int64Value(spec.DiskCount)
+	}
+	if spec.DiskSize != 0 {
+		model.DiskSize = types.Int64Value(spec.DiskSize)
+	}
+	return []DiskSpecModel{model}
+}
+
+func expandOVHAttributes(attrs []OVHAttributesModel) *client.InstancePoolOVHAttributes {
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	return &client.InstancePoolOVHAttributes{
+		BillingPeriod:    attrs[0].BillingPeriod.ValueString(),
+		AvailabilityZone: attrs[0].AvailabilityZone.ValueString(),
+	}
+}
+
+// flattenOVHAttributes converts the OVH settings of a pool. The API also
+// returns the default settings of pools created without them, which only give
+// a block when prior has one, since a block missing from the configuration
+// would otherwise recreate the pool.
+func flattenOVHAttributes(prior []OVHAttributesModel, attrs *client.InstancePoolOVHAttributes) []OVHAttributesModel {
+	if attrs == nil {
+		return []OVHAttributesModel{}
+	}
+
+	billingPeriod := attrs.BillingPeriod
+	if billingPeriod == "" {
+		billingPeriod = client.InstancePoolBillingHourly
+	}
+	if len(prior) == 0 && billingPeriod == client.InstancePoolBillingHourly && attrs.AvailabilityZone == "" {
+		return []OVHAttributesModel{}
+	}
+	return []OVHAttributesModel{{
+		BillingPeriod:    types.StringValue(billingPeriod),
+		AvailabilityZone: stringValueOrNull(attrs.AvailabilityZone),
+	}}
+}
+
+// flattenInstancePoolStats converts the stats of a pool, which the API omits
+// while the pool has no instances.
+func flattenInstancePoolStats(ctx context.Context, stats *client.InstancePoolStats, diags *diag.Diagnostics) types.Object {
+	if stats == nil {
+		stats = &client.InstancePoolStats{}
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, instancePoolStatsAttrTypes, InstancePoolStatsModel{
+		UsedCount:        types.Int64Value(stats.UsedCount),
+		IdleCount:        types.Int64Value(stats.IdleCount),
+		PendingUsedCount: types.Int64Value(stats.PendingUsedCount),
+		PendingIdleCount: types.Int64Value(stats.PendingIdleCount),
+	})
+	diags.Append(d...)
+	return obj
 }
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+)
+
+func TestFlattenOVHAttributes(t *testing.T) {
+	configured := []OVHAttributesModel{{
+		BillingPeriod:    types.StringValue(client.InstancePoolBillingHourly),
+		AvailabilityZone: types.StringNull(),
+	}}
+
+	tests := map[string]struct {
+		prior     []OVHAttributesModel
+		attrs     *client.InstancePoolOVHAttributes
+		wantBlock bool
+	}{
+		"omitted": {
+			attrs: nil,
+		},
+		"defaults without block": {
+			attrs: &client.InstancePoolOVHAttributes{BillingPeriod: client.InstancePoolBillingHourly},
+		},
+		"empty defaults without block": {
+			attrs: &client.InstancePoolOVHAttributes{},
+		},
+		"defaults with block": {
+			prior:     configured,
+			attrs:     &client.InstancePoolOVHAttributes{BillingPeriod: client.InstancePoolBillingHourly},
+			wantBlock: true,
+		},
+		"monthly without block": {
+			attrs:     &client.InstancePoolOVHAttributes{BillingPeriod: client.InstancePoolBillingMonthly},
+			wantBlock: true,
+		},
+		"availability zone without block": {
+			attrs:     &client.InstancePoolOVHAttributes{AvailabilityZone: "eu-west-par-a"},
+			wantBlock: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := flattenOVHAttributes(tt.prior, tt.attrs)
+			if (len(got) == 1) != tt.wantBlock {
+				t.Fatalf("got %v, want block: %t", got, tt.wantBlock)
+			}
+			if tt.wantBlock && got[0].BillingPeriod.IsNull() {
+				t.Errorf("got null billing_period")
+			}
+		})
+	}
+}
@@ -3,18 +3,36 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+)
+
+const (
+	jobCreateTimeout = 5 * time.Minute
+	jobReadTimeout   = 5 * time.Minute
+	jobUpdateTimeout = 5 * time.Minute
+	jobDeleteTimeout = 5 * time.Minute
 )
 
 var _ resource.Resource = &DatabricksJobResource{}
 var _ resource.ResourceWithImportState = &DatabricksJobResource{}
+var _ resource.ResourceWithValidateConfig = &DatabricksJobResource{}
+var _ resource.ResourceWithConfigValidators = &DatabricksJobResource{}
+var _ resource.ResourceWithModifyPlan = &DatabricksJobResource{}
 
 func NewDatabricksJobResource() resource.Resource {
 	return &DatabricksJobResource{}
@@ -25,12 +43,49 @@ type DatabricksJobResource struct {
 }
 
 type DatabricksJobResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	WorkspaceID types.String `tfsdk:"workspace_id"`
-	Name        types.String `tfsdk:"name"`
-	JobID       types.String `tfsdk:"job_id"`
-	Status      types.String `tfsdk:"status"`
-	CreatedTime types.String `tfsdk:"created_time"`
+	ID                     types.String              `tfsdk:"id"`
+	ProjectID              types.String              `tfsdk:"project_id"`
+	WorkspaceID            types.String              `tfsdk:"workspace_id"`
+	Name                   types.String              `tfsdk:"name"`
+	NewCluster             []ClusterSpecModel        `tfsdk:"new_cluster"`
+	ExistingClusterID      types.String              `tfsdk:"existing_cluster_id"`
+	NotebookTask           []NotebookTaskModel       `tfsdk:"notebook_task"`
+	SparkJarTask           []SparkJarTaskModel       `tfsdk:"spark_jar_task"`
+	SparkPythonTask        []SparkPythonTaskModel    `tfsdk:"spark_python_task"`
+	SparkSubmitTask        []SparkSubmitTaskModel    `tfsdk:"spark_submit_task"`
+	PipelineTask           []PipelineTaskModel       `tfsdk:"pipeline_task"`
+	PythonWheelTask        []PythonWheelTaskModel    `tfsdk:"python_wheel_task"`
+	Libraries              []LibraryModel            `tfsdk:"libraries"`
+	EmailNotifications     []EmailNotificationsModel `tfsdk:"email_notifications"`
+	TimeoutSeconds         types.Int64               `tfsdk:"timeout_seconds"`
+	MaxRetries             types.Int64               `tfsdk:"max_retries"`
+	MinRetryIntervalMillis types.Int64               `tfsdk:"min_retry_interval_millis"`
+	RetryOnTimeout         types.Bool                `tfsdk:"retry_on_timeout"`
+	Schedule               []CronScheduleModel       `tfsdk:"schedule"`
+	MaxConcurrentRuns      types.Int64               `tfsdk:"max_concurrent_runs"`
+	Tags                   types.Map                 `tfsdk:"tags"`
+	Tasks                  []JobTaskModel            `tfsdk:"task"`
+	JobClusters            []JobClusterModel         `tfsdk:"job_cluster"`
+	JobID                  types.String              `tfsdk:"job_id"`
+	Status                 types.String              `tfsdk:"status"`
+	CreatorUserName        types.String              `tfsdk:"creator_user_name"`
+	CreatedTime            types.String              `tfsdk:"created_time"`
+	Timeouts               timeouts.Value            `tfsdk:"timeouts"`
+}
+
+// EmailNotificationsModel describes an email_notifications block.
+type EmailNotificationsModel struct {
+	OnStart               types.List `tfsdk:"on_start"`
+	OnSuccess             types.List `tfsdk:"on_success"`
+	OnFailure             types.List `tfsdk:"on_failure"`
+	NoAlertForSkippedRuns types.Bool `tfsdk:"no_alert_for_skipped_runs"`
+}
+
+// CronScheduleModel describes a schedule block.
+type CronScheduleModel struct {
+	QuartzCronExpression types.String `tfsdk:"quartz_cron_expression"`
+	TimezoneID           types.String `tfsdk:"timezone_id"`
+	PauseStatus          types.String `tfsdk:"pause_status"`
 }
 
 func (r *DatabricksJobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -38,6 +93,56 @@ func (r *DatabricksJobResource) Metadata(ctx context.Context, req resource.Metad
 }
 
 func (r *DatabricksJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	blocks := jobTaskBlocks()
+	blocks["new_cluster"] = clusterSpecBlock()
+	blocks["libraries"] = librariesBlock()
+	blocks["task"] = jobTaskBlock()
+	blocks["job_cluster"] = jobClusterBlock()
+	blocks["timeouts"] = timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+	blocks["email_notifications"] = singleBlock("Email notifications", map[string]schema.Attribute{
+		"on_start": schema.ListAttribute{
+			Description: "On start emails",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"on_success": schema.ListAttribute{
+			Description: "On success emails",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"on_failure": schema.ListAttribute{
+			Description: "On failure emails",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"no_alert_for_skipped_runs": schema.BoolAttribute{
+			Description: "No alert for skipped runs",
+			Optional:    true,
+		},
+	})
+	blocks["schedule"] = singleBlock("Schedule configuration", map[string]schema.Attribute{
+		"quartz_cron_expression": schema.StringAttribute{
+			Description: "Quartz cron expression",
+			Required:    true,
+		},
+		"timezone_id": schema.StringAttribute{
+			Description: "Timezone ID",
+			Required:    true,
+		},
+		"pause_status": schema.StringAttribute{
+			Description: "Pause status, either PAUSED or UNPAUSED",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("PAUSED", "UNPAUSED"),
+			},
+		},
+	})
+
 	resp.Schema = schema.Schema{
 		Description: "Manages a Databricks job on OVH infrastructure",
 		Attributes: map[string]schema.Attribute{
@@ -48,6 +153,15 @@ func (r *DatabricksJobResource) Schema(ctx context.Context, req resource.SchemaR
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"project_id": schema.StringAttribute{
+				Description: "OVH Public Cloud project ID. Defaults to the provider ovh_project_id",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"workspace_id": schema.StringAttribute{
 				Description: "Workspace ID",
 				Required:    true,
@@ -59,6 +173,37 @@ func (r *DatabricksJobResource) Schema(ctx context.Context, req resource.SchemaR
 				Description: "Job name",
 				Required:    true,
 			},
+			"existing_cluster_id": schema.StringAttribute{
+				Description: "Existing cluster ID",
+				Optional:    true,
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "Timeout in seconds",
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum retries",
+				Optional:    true,
+			},
+			"min_retry_interval_millis": schema.Int64Attribute{
+				Description: "Minimum retry interval in milliseconds",
+				Optional:    true,
+			},
+			"retry_on_timeout": schema.BoolAttribute{
+				Description: "Retry on timeout",
+				Optional:    true,
+			},
+			"max_concurrent_runs": schema.Int64Attribute{
+				Description: "Maximum concurrent runs",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+			},
+			"tags": schema.MapAttribute{
+				Description: "Job tags",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 			"job_id": schema.StringAttribute{
 				Description: "Databricks job ID",
 				Computed:    true,
@@ -67,11 +212,136 @@ func (r *DatabricksJobResource) Schema(ctx context.Context, req resource.SchemaR
 				Description: "Job status",
 				Computed:    true,
 			},
+			"creator_user_name": schema.StringAttribute{
+				Description: "User who created the job",
+				Computed:    true,
+			},
 			"created_time": schema.StringAttribute{
 				Description: "Creation timestamp",
 				Computed:    true,
 			},
 		},
+		Blocks: blocks,
+	}
+}
+
+func (r *DatabricksJobResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		jobTaskGraphValidator{},
+	}
+}
+
+func (r *DatabricksJobResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateJobTaskSettings(ctx, req.Config, path.Empty(), []string{"existing_cluster_id"}, &resp.Diagnostics)
+
+	var tasks types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("task"), &tasks)...)
+	if len(tasks.Elements()) == 0 {
+		return
+	}
+
+	// The single-task settings describe what the job runs, which the task
+	// blocks take over in a multi-task job.
+	singleTask := configuredBlocks(ctx, req.Config, path.Empty(), append([]string{"new_cluster", "libraries"}, jobTaskTypes...), &resp.Diagnostics)
+	var existingClusterID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("existing_cluster_id"), &existingClusterID)...)
+	if !existingClusterID.IsNull() {
+		singleTask = append(singleTask, "existing_cluster_id")
+	}
+	for _, name := range singleTask {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(name),
+			"Conflicting Job Task Configuration",
+			fmt.Sprintf("%s cannot be set together with task blocks. Move it into the tasks that need it.", name),
+		)
+	}
+
+	for i := range tasks.Elements() {
+		base := path.Root("task").AtListIndex(i)
+		validateJobTaskSettings(ctx, req.Config, base, []string{"existing_cluster_id", "job_cluster_key"}, &resp.Diagnostics)
+
+		if len(configuredBlocks(ctx, req.Config, base, jobTaskTypes, &resp.Diagnostics)) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				base,
+				"Missing Task Type",
+				fmt.Sprintf("Each task must set one of %s.", strings.Join(jobTaskTypes, ", ")),
+			)
+		}
+	}
+}
+
+// ModifyPlan checks the new clusters of the job against their cluster
+// policies, when the policies already exist. Policies created in the same
+// plan are only checked during apply, which is reported as a warning.
+//
+// Unlike the cluster resource, no policy default is planned: a new_cluster
+// block has no computed attribute, so attributes left out of the
+// configuration stay null and Databricks applies the defaults when it
+// creates the cluster.
+func (r *DatabricksJobResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) || r.client == nil {
+		return
+	}
+
+	// A plan that cannot be read yet, such as one with unknown blocks, is
+	// checked during apply instead.
+	var data DatabricksJobResourceModel
+	if diags := req.Plan.Get(ctx, &data); diags.HasError() {
+		return
+	}
+
+	var projectID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+	if resp.Diagnostics.HasError() || projectID.IsUnknown() {
+		return
+	}
+
+	type newCluster struct {
+		path path.Path
+		spec ClusterSpecModel
+	}
+	var clusters []newCluster
+	add := func(base path.Path, specs []ClusterSpecModel) {
+		if len(specs) == 0 || specs[0].PolicyID.IsNull() {
+			return
+		}
+		clusterPath := base.AtName("new_cluster").AtListIndex(0)
+		if specs[0].PolicyID.IsUnknown() {
+			addUnknownPolicyWarning(&resp.Diagnostics, clusterPath.AtName("policy_id"))
+			return
+		}
+		clusters = append(clusters, newCluster{path: clusterPath, spec: specs[0]})
+	}
+	add(path.Empty(), data.NewCluster)
+	for i, task := range data.Tasks {
+		add(path.Root("task").AtListIndex(i), task.NewCluster)
+	}
+	for i, cluster := range data.JobClusters {
+		add(path.Root("job_cluster").AtListIndex(i), cluster.NewCluster)
+	}
+	if len(clusters) == 0 {
+		return
+	}
+
+	// Missing credentials are reported when the job is applied.
+	api, diags := r.client.projectAPI(&data.ProjectID)
+	if diags.HasError() {
+		return
+	}
+
+	// Clusters often share a policy, which is only looked up once.
+	policies := map[string]*clusterPolicy{}
+	for _, cluster := range clusters {
+		policyID := cluster.spec.PolicyID.ValueString()
+
+		policy, ok := policies[policyID]
+		if !ok {
+			policy = fetchClusterPolicy(ctx, api, policyID, cluster.path.AtName("policy_id"), &resp.Diagnostics)
+			policies[policyID] = policy
+		}
+		if policy != nil {
+			policy.evaluate(clusterSpecPolicyAttributes(cluster.spec, cluster.path), &resp.Diagnostics)
+		}
 	}
 }
 
@@ -100,33 +370,40 @@ func (r *DatabricksJobResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	tflog.Trace(ctx, "creating databricks job resource")
-
-	jobConfig := map[string]interface{}{
-		"workspaceId": data.WorkspaceID.ValueString(),
-		"name":        data.Name.ValueString(),
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	data.ProjectID = types.StringValue(projectID)
 
-	var result map[string]interface{}
-	err := r.client.OVHClient.Post("/cloud/project/databricks/job", jobConfig, &result)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create job, got error: %s", err))
+	createTimeout, diags := data.Timeouts.Create(ctx, jobCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	jobId := result["id"].(string)
-	data.ID = types.StringValue(jobId)
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
-	if databricksJobId, ok := result["jobId"].(string); ok {
-		data.JobID = types.StringValue(databricksJobId)
-	}
-	if status, ok := result["status"].(string); ok {
-		data.Status = types.StringValue(status)
+	tflog.Trace(ctx, "creating databricks job resource")
+
+	settings := data.expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if createdTime, ok := result["createdTime"].(string); ok {
-		data.CreatedTime = types.StringValue(createdTime)
+
+	job, err := r.client.API.Project(projectID).CreateJob(ctx, &client.JobCreateRequest{
+		JobSettings: settings,
+		WorkspaceID: data.WorkspaceID.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "create job", err)
+		return
 	}
 
+	data.refresh(ctx, job, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created databricks job resource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -140,29 +417,35 @@ func (r *DatabricksJobResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	var job map[string]interface{}
-	err := r.client.OVHClient.Get(fmt.Sprintf("/cloud/project/databricks/job/%s", data.ID.ValueString()), &job)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read job, got error: %s", err))
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	data.ProjectID = types.StringValue(projectID)
 
-	if workspaceId, ok := job["workspaceId"].(string); ok {
-		data.WorkspaceID = types.StringValue(workspaceId)
-	}
-	if name, ok := job["name"].(string); ok {
-		data.Name = types.StringValue(name)
-	}
-	if jobId, ok := job["jobId"].(string); ok {
-		data.JobID = types.StringValue(jobId)
+	readTimeout, diags := data.Timeouts.Read(ctx, jobReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if status, ok := job["status"].(string); ok {
-		data.Status = types.StringValue(status)
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	job, err := r.client.API.Project(projectID).GetJob(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "databricks job not found, removing from state", map[string]any{"id": data.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
 	}
-	if createdTime, ok := job["createdTime"].(string); ok {
-		data.CreatedTime = types.StringValue(createdTime)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read job", err)
+		return
 	}
 
+	data.refresh(ctx, job, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -174,16 +457,45 @@ func (r *DatabricksJobResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	updateConfig := map[string]interface{}{
-		"name": data.Name.ValueString(),
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ProjectID = types.StringValue(projectID)
+
+	settings := data.expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	err := r.client.OVHClient.Put(fmt.Sprintf("/cloud/project/databricks/job/%s", data.ID.ValueString()), updateConfig, nil)
+	updateTimeout, diags := data.Timeouts.Update(ctx, jobUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	api := r.client.API.Project(projectID)
+
+	err := api.UpdateJob(ctx, data.ID.ValueString(), &client.JobUpdateRequest{
+		JobSettings: settings,
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update job, got error: %s", err))
+		addClientError(&resp.Diagnostics, "update job", err)
 		return
 	}
 
+	job, err := api.GetJob(ctx, data.ID.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read job", err)
+		return
+	}
+
+	data.refresh(ctx, job, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -195,13 +507,144 @@ func (r *DatabricksJobResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	err := r.client.OVHClient.Delete(fmt.Sprintf("/cloud/project/databricks/job/%s", data.ID.ValueString()), nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete job, got error: %s", err))
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, jobDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.API.Project(projectID).DeleteJob(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "delete job", err)
 		return
 	}
 }
 
 func (r *DatabricksJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateWithProject(ctx, req, resp)
+}
+
+// expand builds the job settings sent to the API from the model.
+func (data *DatabricksJobResourceModel) expand(ctx context.Context, diags *diag.Diagnostics) client.JobSettings {
+	return client.JobSettings{
+		Name:                   data.Name.ValueString(),
+		NewCluster:             expandClusterSpec(data.NewCluster),
+		ExistingClusterID:      data.ExistingClusterID.ValueString(),
+		NotebookTask:           expandNotebookTask(ctx, data.NotebookTask, diags),
+		SparkJarTask:           expandSparkJarTask(ctx, data.SparkJarTask, diags),
+		SparkPythonTask:        expandSparkPythonTask(ctx, data.SparkPythonTask, diags),
+		SparkSubmitTask:        expandSparkSubmitTask(ctx, data.SparkSubmitTask, diags),
+		PipelineTask:           expandPipelineTask(data.PipelineTask),
+		PythonWheelTask:        expandPythonWheelTask(ctx, data.PythonWheelTask, diags),
+		Libraries:              expandLibraries(ctx, data.Libraries, diags),
+		EmailNotifications:     expandEmailNotifications(ctx, data.EmailNotifications, diags),
+		TimeoutSeconds:         data.TimeoutSeconds.ValueInt64Pointer(),
+		MaxRetries:             data.MaxRetries.ValueInt64Pointer(),
+		MinRetryIntervalMillis: data.MinRetryIntervalMillis.ValueInt64Pointer(),
+		RetryOnTimeout:         data.RetryOnTimeout.ValueBoolPointer(),
+		Schedule:               expandCronSchedule(data.Schedule),
+		MaxConcurrentRuns:      data.MaxConcurrentRuns.ValueInt64Pointer(),
+		Tags:                   mapToStrings(ctx, data.Tags, diags),
+		Tasks:                  expandJobTasks(ctx, data.Tasks, diags),
+		JobClusters:            expandJobClusters(data.JobClusters),
+	}
+}
+
+// refresh copies the API representation of a job into the model.
+func (data *DatabricksJobResourceModel) refresh(ctx context.Context, job *client.Job, diags *diag.Diagnostics) {
+	data.ID = types.StringValue(job.ID.String())
+
+	if job.WorkspaceID != "" {
+		data.WorkspaceID = types.StringValue(job.WorkspaceID)
+	}
+	if job.Name != "" {
+		data.Name = types.StringValue(job.Name)
+	}
+
+	data.NewCluster = flattenClusterSpec(job.NewCluster)
+	data.ExistingClusterID = stringValueOrNull(job.ExistingClusterID)
+	data.NotebookTask = flattenNotebookTask(ctx, job.NotebookTask, diags)
+	data.SparkJarTask = flattenSparkJarTask(ctx, job.SparkJarTask, diags)
+	data.SparkPythonTask = flattenSparkPythonTask(ctx, job.SparkPythonTask, diags)
+	data.SparkSubmitTask = flattenSparkSubmitTask(ctx, job.SparkSubmitTask, diags)
+	data.PipelineTask = flattenPipelineTask(job.PipelineTask)
+	data.PythonWheelTask = flattenPythonWheelTask(ctx, job.PythonWheelTask, diags)
+	data.Libraries = flattenLibraries(ctx, job.Libraries, diags)
+	data.EmailNotifications = flattenEmailNotifications(ctx, job.EmailNotifications, diags)
+	data.TimeoutSeconds = types.Int64PointerValue(job.TimeoutSeconds)
+	data.MaxRetries = types.Int64PointerValue(job.MaxRetries)
+	data.MinRetryIntervalMillis = types.Int64PointerValue(job.MinRetryIntervalMillis)
+	data.RetryOnTimeout = types.BoolPointerValue(job.RetryOnTimeout)
+	data.Schedule = flattenCronSchedule(job.Schedule)
+	if job.MaxConcurrentRuns != nil {
+		data.MaxConcurrentRuns = types.Int64Value(*job.MaxConcurrentRuns)
+	}
+	data.Tags = stringsToMap(ctx, job.Tags, diags)
+	data.Tasks = flattenJobTasks(ctx, job.Tasks, diags)
+	data.JobClusters = flattenJobClusters(job.JobClusters)
+
+	data.JobID = types.StringValue(job.JobID.String())
+	data.Status = types.StringValue(job.Status)
+	data.CreatorUserName = types.StringValue(job.CreatorUserName)
+	data.CreatedTime = types.StringValue(job.CreatedTime.String())
+}
+
+func expandEmailNotifications(ctx context.Context, notifications []EmailNotificationsModel, diags *diag.Diagnostics) *client.EmailNotifications {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	n := notifications[0]
+	return &client.EmailNotifications{
+		OnStart:               listToStrings(ctx, n.OnStart, diags),
+		OnSuccess:             listToStrings(ctx, n.OnSuccess, diags),
+		OnFailure:             listToStrings(ctx, n.OnFailure, diags),
+		NoAlertForSkippedRuns: n.NoAlertForSkippedRuns.ValueBoolPointer(),
+	}
+}
+
+func flattenEmailNotifications(ctx context.Context, n *client.EmailNotifications, diags *diag.Diagnostics) []EmailNotificationsModel {
+	if n == nil {
+		return []EmailNotificationsModel{}
+	}
+
+	return []EmailNotificationsModel{{
+		OnStart:               stringsToList(ctx, n.OnStart, diags),
+		OnSuccess:             stringsToList(ctx, n.OnSuccess, diags),
+		OnFailure:             stringsToList(ctx, n.OnFailure, diags),
+		NoAlertForSkippedRuns: types.BoolPointerValue(n.NoAlertForSkippedRuns),
+	}}
+}
+
+func expandCronSchedule(schedules []CronScheduleModel) *client.CronSchedule {
+	if len(schedules) == 0 {
+		return nil
+	}
+
+	return &client.CronSchedule{
+		QuartzCronExpression: schedules[0].QuartzCronExpression.ValueString(),
+		TimezoneID:           schedules[0].TimezoneID.ValueString(),
+		PauseStatus:          schedules[0].PauseStatus.ValueString(),
+	}
+}
+
+func flattenCronSchedule(schedule *client.CronSchedule) []CronScheduleModel {
+	if schedule == nil {
+		return []CronScheduleModel{}
+	}
+
+	return []CronScheduleModel{{
+		QuartzCronExpression: types.StringValue(schedule.QuartzCronExpression),
+		TimezoneID:           types.StringValue(schedule.TimezoneID),
+		PauseStatus:          stringValueOrNull(schedule.PauseStatus),
+	}}
 }
@@ -0,0 +1,344 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+)
+
+const (
+	jobRunCreateTimeout = 60 * time.Minute
+	jobRunReadTimeout   = 5 * time.Minute
+	jobRunDeleteTimeout = 5 * time.Minute
+)
+
+var _ resource.Resource = &DatabricksJobRunResource{}
+
+func NewDatabricksJobRunResource() resource.Resource {
+	return &DatabricksJobRunResource{}
+}
+
+// DatabricksJobRunResource triggers a run of a job when it is created, and a
+// new one whenever its parameters or triggers change. Destroying it does not
+// affect finished runs.
+type DatabricksJobRunResource struct {
+	client *Config
+}
+
+type DatabricksJobRunResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	ProjectID         types.String   `tfsdk:"project_id"`
+	JobID             types.String   `tfsdk:"job_id"`
+	NotebookParams    types.Map      `tfsdk:"notebook_params"`
+	PythonParams      types.List     `tfsdk:"python_params"`
+	Triggers          types.Map      `tfsdk:"triggers"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	RunID             types.String   `tfsdk:"run_id"`
+	LifeCycleState    types.String   `tfsdk:"life_cycle_state"`
+	ResultState       types.String   `tfsdk:"result_state"`
+	StateMessage      types.String   `tfsdk:"state_message"`
+	RunPageURL        types.String   `tfsdk:"run_page_url"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DatabricksJobRunResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_run"
+}
+
+func (r *DatabricksJobRunResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Triggers a run of a Databricks job on OVH infrastructure. A new run is started whenever job_id, the parameters or triggers change",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Job run identifier",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "OVH Public Cloud project ID. Defaults to the provider ovh_project_id",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"job_id": schema.StringAttribute{
+				Description: "Identifier of the databricks-ovh_job to run",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notebook_params": schema.MapAttribute{
+				Description: "Notebook parameters overriding the base_parameters of the job for this run",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"python_params": schema.ListAttribute{
+				Description: "Python parameters overriding the parameters of the job for this run",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that start a new run when changed",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "Wait for the run to terminate and fail if it does not succeed. Defaults to true",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"run_id": schema.StringAttribute{
+				Description: "Databricks run ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"life_cycle_state": schema.StringAttribute{
+				Description: "Life-cycle state of the run",
+				Computed:    true,
+			},
+			"result_state": schema.StringAttribute{
+				Description: "Result state of the run, set once it has terminated",
+				Computed:    true,
+			},
+			"state_message": schema.StringAttribute{
+				Description: "Message describing the state of the run",
+				Computed:    true,
+			},
+			"run_page_url": schema.StringAttribute{
+				Description: "URL of the run in the Databricks workspace",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *DatabricksJobRunResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DatabricksJobRunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatabricksJobRunResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ProjectID = types.StringValue(projectID)
+
+	runReq := &client.JobRunNowRequest{
+		NotebookParams: mapToStrings(ctx, data.NotebookParams, &resp.Diagnostics),
+		PythonParams:   listToStrings(ctx, data.PythonParams, &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, jobRunCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	tflog.Trace(ctx, "triggering databricks job run", map[string]any{"job_id": data.JobID.ValueString()})
+
+	api := r.client.API.Project(projectID)
+
+	run, err := api.RunJobNow(ctx, data.JobID.ValueString(), runReq)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "run job", err)
+		return
+	}
+
+	data.refresh(run)
+
+	// Save the run before waiting so that a failed run is tracked (and
+	// tainted, which triggers a new run on the next apply).
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() || !data.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	tflog.Debug(ctx, "waiting for databricks job run to terminate", map[string]any{"run_id": data.RunID.ValueString()})
+
+	run, err = api.WaitForJobRunTerminated(ctx, data.RunID.ValueString())
+	if run != nil {
+		data.refresh(run)
+	}
+	if err != nil {
+		if !addContextError(&resp.Diagnostics, fmt.Sprintf("wait for run %s of job %s", data.RunID.ValueString(), data.JobID.ValueString()), err) {
+			resp.Diagnostics.AddError("Job Run Error", fmt.Sprintf("Run %s of job %s did not succeed: %s. See %s", data.RunID.ValueString(), data.JobID.ValueString(), err, data.RunPageURL.ValueString()))
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabricksJobRunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatabricksJobRunResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, jobRunReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	data.ProjectID = types.StringValue(projectID)
+
+	run, err := r.client.API.Project(projectID).GetJobRun(ctx, data.RunID.ValueString())
+	if client.IsNotFound(err) {
+		// Run history expires. Removing the run from state would trigger a
+		// new run on the next apply, so the last known state is kept.
+		tflog.Debug(ctx, "databricks job run not found, keeping last known state", map[string]any{"run_id": data.RunID.ValueString()})
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read job run", err)
+		return
+	}
+
+	data.refresh(run)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only stores wait_for_completion and timeouts, which do not start a
+// new run.
+func (r *DatabricksJobRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DatabricksJobRunResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.LifeCycleState = state.LifeCycleState
+	data.ResultState = state.ResultState
+	data.StateMessage = state.StateMessage
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete cancels the run if it is still active. Finished runs are left in the
+// job history.
+func (r *DatabricksJobRunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DatabricksJobRunResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if (client.RunState{LifeCycleState: data.LifeCycleState.ValueString()}).Terminal() {
+		return
+	}
+
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, jobRunDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.API.Project(projectID).CancelJobRun(ctx, data.RunID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "cancel job run", err)
+		return
+	}
+}
+
+// refresh copies the API representation of a job run into the model.
+func (data *DatabricksJobRunResourceModel) refresh(run *client.JobRun) {
+	data.ID = types.StringValue(run.RunID.String())
+	data.RunID = types.StringValue(run.RunID.String())
+	data.LifeCycleState = stringValueOrNull(run.State.LifeCycleState)
+	data.ResultState = stringValueOrNull(run.State.ResultState)
+	data.StateMessage = stringValueOrNull(run.State.StateMessage)
+	if run.RunPageURL != "" || data.RunPageURL.IsUnknown() {
+		data.RunPageURL = stringValueOrNull(run.RunPageURL)
+	}
+}
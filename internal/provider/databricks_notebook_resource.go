@@ -3,14 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
 )
 
 var _ resource.Resource = &DatabricksNotebookResource{}
@@ -25,14 +27,16 @@ type DatabricksNotebookResource struct {
 }
 
 type DatabricksNotebookResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	WorkspaceID types.String `tfsdk:"workspace_id"`
-	Path        types.String `tfsdk:"path"`
-	Language    types.String `tfsdk:"language"`
-	Content     types.String `tfsdk:"content"`
-	Format      types.String `tfsdk:"format"`
-	NotebookID  types.String `tfsdk:"notebook_id"`
-	CreatedTime types.String `tfsdk:"created_time"`
+	ID           types.String `tfsdk:"id"`
+	ProjectID    types.String `tfsdk:"project_id"`
+	WorkspaceURL types.String `tfsdk:"workspace_url"`
+	WorkspaceID  types.String `tfsdk:"workspace_id"`
+	Path         types.String `tfsdk:"path"`
+	Language     types.String `tfsdk:"language"`
+	Content      types.String `tfsdk:"content"`
+	Format       types.String `tfsdk:"format"`
+	NotebookID   types.String `tfsdk:"notebook_id"`
+	CreatedTime  types.String `tfsdk:"created_time"`
 }
 
 func (r *DatabricksNotebookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -50,6 +54,15 @@ func (r *DatabricksNotebookResource) Schema(ctx context.Context, req resource.Sc
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"project_id": schema.StringAttribute{
+				Description: "OVH Public Cloud project ID. Defaults to the provider ovh_project_id",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"workspace_id": schema.StringAttribute{
 				Description: "Workspace ID",
 				Required:    true,
@@ -57,9 +70,17 @@ func (r *DatabricksNotebookResource) Schema(ctx context.Context, req resource.Sc
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"workspace_url": workspaceURLAttribute(),
 			"path": schema.StringAttribute{
-				Description: "Notebook path",
+				Description: "Notebook path. Changing it replaces the notebook when workspace_url is set, as the workspace API cannot move notebooks",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						workspaceURLIsSet,
+						"Notebooks managed through the workspace API cannot be moved.",
+						"Notebooks managed through the workspace API cannot be moved.",
+					),
+				},
 			},
 			"language": schema.StringAttribute{
 				Description: "Notebook language",
@@ -110,32 +131,27 @@ func (r *DatabricksNotebookResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	tflog.Trace(ctx, "creating databricks notebook resource")
-
-	notebookConfig := map[string]interface{}{
-		"workspaceId": data.WorkspaceID.ValueString(),
-		"path":        data.Path.ValueString(),
-		"language":    data.Language.ValueString(),
-		"content":     data.Content.ValueString(),
-		"format":      data.Format.ValueString(),
+	api, diags := r.api(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	var result map[string]interface{}
-	err := r.client.OVHClient.Post("/cloud/project/databricks/notebook", notebookConfig, &result)
+	tflog.Trace(ctx, "creating databricks notebook resource")
+
+	notebook, err := api.CreateNotebook(ctx, &client.NotebookCreateRequest{
+		WorkspaceID: data.WorkspaceID.ValueString(),
+		Path:        data.Path.ValueString(),
+		Language:    data.Language.ValueString(),
+		Content:     data.Content.ValueString(),
+		Format:      data.Format.ValueString(),
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create notebook, got error: %s", err))
+		addClientError(&resp.Diagnostics, "create notebook", err)
 		return
 	}
 
-	notebookId := result["id"].(string)
-	data.ID = types.StringValue(notebookId)
-
-	if databricksNotebookId, ok := result["notebookId"].(string); ok {
-		data.NotebookID = types.StringValue(databricksNotebookId)
-	}
-	if createdTime, ok := result["createdTime"].(string); ok {
-		data.CreatedTime = types.StringValue(createdTime)
-	}
+	data.refresh(notebook)
 
 	tflog.Trace(ctx, "created databricks notebook resource")
 
@@ -150,35 +166,25 @@ func (r *DatabricksNotebookResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	var notebook map[string]interface{}
-	err := r.client.OVHClient.Get(fmt.Sprintf("/cloud/project/databricks/notebook/%s", data.ID.ValueString()), &notebook)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read notebook, got error: %s", err))
+	api, diags := r.api(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if workspaceId, ok := notebook["workspaceId"].(string); ok {
-		data.WorkspaceID = types.StringValue(workspaceId)
-	}
-	if path, ok := notebook["path"].(string); ok {
-		data.Path = types.StringValue(path)
-	}
-	if language, ok := notebook["language"].(string); ok {
-		data.Language = types.StringValue(language)
-	}
-	if content, ok := notebook["content"].(string); ok {
-		data.Content = types.StringValue(content)
-	}
-	if format, ok := notebook["format"].(string); ok {
-		data.Format = types.StringValue(format)
-	}
-	if notebookId, ok := notebook["notebookId"].(string); ok {
-		data.NotebookID = types.StringValue(notebookId)
+	notebook, err := api.GetNotebook(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "databricks notebook not found, removing from state", map[string]any{"id": data.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
 	}
-	if createdTime, ok := notebook["createdTime"].(string); ok {
-		data.CreatedTime = types.StringValue(createdTime)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read notebook", err)
+		return
 	}
 
+	data.refresh(notebook)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -190,19 +196,31 @@ func (r *DatabricksNotebookResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
-	updateConfig := map[string]interface{}{
-		"path":     data.Path.ValueString(),
-		"language": data.Language.ValueString(),
-		"content":  data.Content.ValueString(),
-		"format":   data.Format.ValueString(),
+	api, diags := r.api(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	err := r.client.OVHClient.Put(fmt.Sprintf("/cloud/project/databricks/notebook/%s", data.ID.ValueString()), updateConfig, nil)
+	err := api.UpdateNotebook(ctx, data.ID.ValueString(), &client.NotebookUpdateRequest{
+		Path:     data.Path.ValueString(),
+		Language: data.Language.ValueString(),
+		Content:  data.Content.ValueString(),
+		Format:   data.Format.ValueString(),
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update notebook, got error: %s", err))
+		addClientError(&resp.Diagnostics, "update notebook", err)
 		return
 	}
 
+	notebook, err := api.GetNotebook(ctx, data.ID.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read notebook", err)
+		return
+	}
+
+	data.refresh(notebook)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -214,13 +232,59 @@ func (r *DatabricksNotebookResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
-	err := r.client.OVHClient.Delete(fmt.Sprintf("/cloud/project/databricks/notebook/%s", data.ID.ValueString()), nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete notebook, got error: %s", err))
+	api, diags := r.api(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := api.DeleteNotebook(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "delete notebook", err)
 		return
 	}
 }
 
 func (r *DatabricksNotebookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// The workspace API identifies notebooks by their path, such as
+	// /Shared/etl in "https://adb-1234.ovh.net/ws-1/Shared/etl".
+	importStateWithWorkspaceURL(ctx, req, resp, importStateWithProject, func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+		workspaceID, notebookPath, _ := strings.Cut(req.ID, "/")
+		req.ID = workspaceID + "//" + notebookPath
+		importStateInWorkspace(ctx, req, resp)
+	})
+}
+
+// refresh copies the API representation of a notebook into the model.
+func (data *DatabricksNotebookResourceModel) refresh(notebook *client.Notebook) {
+	data.ID = types.StringValue(notebook.ID.String())
+
+	if notebook.WorkspaceID != "" {
+		data.WorkspaceID = types.StringValue(notebook.WorkspaceID)
+	}
+	if notebook.Path != "" {
+		data.Path = types.StringValue(notebook.Path)
+	}
+	// The workspace API reports languages in upper case.
+	if notebook.Language != "" && !strings.EqualFold(notebook.Language, data.Language.ValueString()) {
+		data.Language = types.StringValue(notebook.Language)
+	}
+	if notebook.Content != "" {
+		data.Content = types.StringValue(notebook.Content)
+	}
+	if notebook.Format != "" {
+		data.Format = types.StringValue(notebook.Format)
+	}
+
+	data.NotebookID = types.StringValue(notebook.NotebookID.String())
+	data.CreatedTime = types.StringValue(notebook.CreatedTime.String())
+}
+
+// api returns the client managing the notebook: the workspace API when
+// workspace_url is set, otherwise the OVH API of the project.
+func (r *DatabricksNotebookResource) api(data *DatabricksNotebookResourceModel) (notebookAPI, diag.Diagnostics) {
+	if !data.WorkspaceURL.IsNull() {
+		return r.client.workspaceAPI(data.WorkspaceURL, &data.ProjectID)
+	}
+	return r.client.projectAPI(&data.ProjectID)
 }
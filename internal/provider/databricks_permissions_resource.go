@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/databricks"
+)
+
+var _ resource.Resource = &DatabricksPermissionsResource{}
+var _ resource.ResourceWithImportState = &DatabricksPermissionsResource{}
+
+// permissionObjectTypes are the object types of the permissions API, as they
+// appear in its paths.
+var permissionObjectTypes = []string{
+	"clusters",
+	"cluster-policies",
+	"instance-pools",
+	"jobs",
+	"notebooks",
+	"directories",
+	"pipelines",
+	"repos",
+}
+
+func NewDatabricksPermissionsResource() resource.Resource {
+	return &DatabricksPermissionsResource{}
+}
+
+type DatabricksPermissionsResource struct {
+	client *Config
+}
+
+type DatabricksPermissionsResourceModel struct {
+	ID            types.String         `tfsdk:"id"`
+	ProjectID     types.String         `tfsdk:"project_id"`
+	WorkspaceURL  types.String         `tfsdk:"workspace_url"`
+	ObjectType    types.String         `tfsdk:"object_type"`
+	ObjectID      types.String         `tfsdk:"object_id"`
+	AccessControl []AccessControlModel `tfsdk:"access_control"`
+}
+
+// AccessControlModel describes an access_control block.
+type AccessControlModel struct {
+	UserName             types.String `tfsdk:"user_name"`
+	GroupName            types.String `tfsdk:"group_name"`
+	ServicePrincipalName types.String `tfsdk:"service_principal_name"`
+	PermissionLevel      types.String `tfsdk:"permission_level"`
+}
+
+func (r *DatabricksPermissionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions"
+}
+
+func (r *DatabricksPermissionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	principal := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: description,
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(
+					path.MatchRelative().AtParent().AtName("user_name"),
+					path.MatchRelative().AtParent().AtName("group_name"),
+					path.MatchRelative().AtParent().AtName("service_principal_name"),
+				),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the permissions set directly on a workspace object, such as a cluster, job or notebook, through the Databricks REST API of its workspace. " +
+			"Permissions inherited from a parent, and those of principals not listed, such as the owner of the object, are left out",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Permissions identifier, in the form object_type/object_id",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "OVH Public Cloud project ID. Defaults to the provider ovh_project_id",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace_url": schema.StringAttribute{
+				Description: "URL of the workspace, usually the workspace_url of a databricks-ovh_workspace. Permissions are only managed through the Databricks REST API of the workspace, " +
+					"authenticated with the provider databricks_token, databricks_client_id and databricks_client_secret, or databricks_username and databricks_password",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_type": schema.StringAttribute{
+				Description: "Type of the object, as in the paths of the permissions API: " + strings.Join(permissionObjectTypes, ", "),
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(permissionObjectTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_id": schema.StringAttribute{
+				Description: "Databricks ID of the object, such as the cluster_id of a cluster or the notebook_id of a notebook",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"access_control": schema.SetNestedBlock{
+				Description: "Permission of a user, group or service principal",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"user_name":              principal("User the permission is granted to"),
+						"group_name":             principal("Group the permission is granted to"),
+						"service_principal_name": principal("Application ID of the service principal the permission is granted to"),
+						"permission_level": schema.StringAttribute{
+							Description: "Permission level, such as CAN_MANAGE, CAN_RESTART or CAN_ATTACH_TO for a cluster, or CAN_VIEW or CAN_MANAGE_RUN for a job",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DatabricksPermissionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DatabricksPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatabricksPermissionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := r.client.workspaceAPI(data.WorkspaceURL, &data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "creating databricks permissions resource")
+
+	err := api.SetPermissions(ctx, data.ObjectType.ValueString(), data.ObjectID.ValueString(), expandAccessControl(data.AccessControl))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "set permissions", err)
+		return
+	}
+
+	data.ID = types.StringValue(data.ObjectType.ValueString() + "/" + data.ObjectID.ValueString())
+
+	tflog.Trace(ctx, "created databricks permissions resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabricksPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatabricksPermissionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := r.client.workspaceAPI(data.WorkspaceURL, &data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	perms, err := api.GetPermissions(ctx, data.ObjectType.ValueString(), data.ObjectID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "databricks permissions object not found, removing from state", map[string]any{"id": data.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read permissions", err)
+		return
+	}
+
+	// The permissions of an imported object are all kept, as if configured.
+	prior := data.AccessControl
+	if readingImport(ctx, req, resp) {
+		prior = nil
+	}
+	data.AccessControl = flattenAccessControl(prior, perms.AccessControlList)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabricksPermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DatabricksPermissionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := r.client.workspaceAPI(data.WorkspaceURL, &data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := api.SetPermissions(ctx, data.ObjectType.ValueString(), data.ObjectID.ValueString(), expandAccessControl(data.AccessControl))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "update permissions", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the permissions set directly on the object. Its owner and
+// the workspace admins keep their access.
+func (r *DatabricksPermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DatabricksPermissionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := r.client.workspaceAPI(data.WorkspaceURL, &data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := api.SetPermissions(ctx, data.ObjectType.ValueString(), data.ObjectID.ValueString(), []databricks.AccessControl{})
+	if err != nil && !client.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "delete permissions", err)
+		return
+	}
+}
+
+// ImportState imports permissions from "<workspace_url>/<object_type>/<object_id>",
+// such as "https://adb-1234.ovh.net/clusters/0123-456789-abcde".
+func (r *DatabricksPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	withoutURL := func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <workspace_url>/<object_type>/<object_id>. Got: %q", req.ID),
+		)
+	}
+	importKeys := func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+		objectType, objectID, _ := strings.Cut(req.ID, "/")
+		if objectType == "" || objectID == "" {
+			withoutURL(ctx, req, resp)
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_type"), objectType)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_id"), objectID)...)
+	}
+	importStateWithWorkspaceURL(ctx, req, resp, withoutURL, importKeys)
+	markImported(ctx, resp)
+}
+
+func expandAccessControl(acl []AccessControlModel) []databricks.AccessControl {
+	out := make([]databricks.AccessControl, 0, len(acl))
+	for _, a := range acl {
+		out = append(out, databricks.AccessControl{
+			UserName:             a.UserName.ValueString(),
+			GroupName:            a.GroupName.ValueString(),
+			ServicePrincipalName: a.ServicePrincipalName.ValueString(),
+			PermissionLevel:      a.PermissionLevel.ValueString(),
+		})
+	}
+	return out
+}
+
+// flattenAccessControl converts the permissions of the principals in prior,
+// so that those the workspace grants on its own, such as to the owner of the
+// object, are not reported as changes. A nil prior keeps every permission.
+func flattenAccessControl(prior []AccessControlModel, acl []databricks.AccessControl) []AccessControlModel {
+	principals := map[string]bool{}
+	for _, a := range prior {
+		principals[accessControlPrincipal(a.UserName.ValueString(), a.GroupName.ValueString(), a.ServicePrincipalName.ValueString())] = true
+	}
+
+	out := []AccessControlModel{}
+	for _, a := range acl {
+		if prior != nil && !principals[accessControlPrincipal(a.UserName, a.GroupName, a.ServicePrincipalName)] {
+			continue
+		}
+		out = append(out, AccessControlModel{
+			UserName:             stringValueOrNull(a.UserName),
+			GroupName:            stringValueOrNull(a.GroupName),
+			ServicePrincipalName: stringValueOrNull(a.ServicePrincipalName),
+			PermissionLevel:      types.StringValue(a.PermissionLevel),
+		})
+	}
+	return out
+}
+
+// accessControlPrincipal identifies the principal of a permission.
+func accessControlPrincipal(userName, groupName, servicePrincipalName string) string {
+	return userName + "\x00" + groupName + "\x00" + servicePrincipalName
+}
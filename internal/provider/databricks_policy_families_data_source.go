@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &DatabricksPolicyFamiliesDataSource{}
+
+func NewDatabricksPolicyFamiliesDataSource() datasource.DataSource {
+	return &DatabricksPolicyFamiliesDataSource{}
+}
+
+type DatabricksPolicyFamiliesDataSource struct {
+	client *Config
+}
+
+type DatabricksPolicyFamiliesDataSourceModel struct {
+	ID             types.String                            `tfsdk:"id"`
+	ProjectID      types.String                            `tfsdk:"project_id"`
+	Name           types.String                            `tfsdk:"name"`
+	PolicyFamilies []DatabricksPolicyFamilyDataSourceModel `tfsdk:"policy_families"`
+}
+
+type DatabricksPolicyFamilyDataSourceModel struct {
+	PolicyFamilyID types.String `tfsdk:"policy_family_id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Definition     types.String `tfsdk:"definition"`
+}
+
+func (d *DatabricksPolicyFamiliesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_families"
+}
+
+func (d *DatabricksPolicyFamiliesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the built-in policy families that Databricks cluster policies can be created from.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Data source identifier",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "OVH Public Cloud project ID. Defaults to the provider ovh_project_id",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Filter policy families by name, such as Personal Compute",
+				Optional:    true,
+			},
+			"policy_families": schema.ListNestedAttribute{
+				Description: "List of policy families",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"policy_family_id": schema.StringAttribute{
+							Description: "Policy family ID, used as the policy_family_id of a databricks-ovh_cluster_policy",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Policy family name",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Policy family description",
+							Computed:    true,
+						},
+						"definition": schema.StringAttribute{
+							Description: "Policy definition JSON of the family",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DatabricksPolicyFamiliesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DatabricksPolicyFamiliesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DatabricksPolicyFamiliesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, diags := d.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Databricks policy families", map[string]any{"project_id": projectID})
+
+	families, err := d.client.API.Project(projectID).ListPolicyFamilies(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read policy families", err)
+		return
+	}
+
+	data.PolicyFamilies = []DatabricksPolicyFamilyDataSourceModel{}
+	for _, family := range families {
+		if !data.Name.IsNull() && family.Name != data.Name.ValueString() {
+			continue
+		}
+
+		data.PolicyFamilies = append(data.PolicyFamilies, DatabricksPolicyFamilyDataSourceModel{
+			PolicyFamilyID: types.StringValue(family.PolicyFamilyID),
+			Name:           types.StringValue(family.Name),
+			Description:    types.StringValue(family.Description),
+			Definition:     types.StringValue(family.Definition),
+		})
+	}
+
+	data.ID = types.StringValue(projectID + "/policy-families")
+	data.ProjectID = types.StringValue(projectID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+)
+
+var _ resource.Resource = &DatabricksSecretACLResource{}
+var _ resource.ResourceWithImportState = &DatabricksSecretACLResource{}
+
+func NewDatabricksSecretACLResource() resource.Resource {
+	return &DatabricksSecretACLResource{}
+}
+
+type DatabricksSecretACLResource struct {
+	client *Config
+}
+
+type DatabricksSecretACLResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ProjectID    types.String `tfsdk:"project_id"`
+	WorkspaceURL types.String `tfsdk:"workspace_url"`
+	WorkspaceID  types.String `tfsdk:"workspace_id"`
+	Scope        types.String `tfsdk:"scope"`
+	Principal    types.String `tfsdk:"principal"`
+	Permission   types.String `tfsdk:"permission"`
+}
+
+func (r *DatabricksSecretACLResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_acl"
+}
+
+func (r *DatabricksSecretACLResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the permission of a principal on a Databricks secret scope on OVH infrastructure",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Secret ACL identifier, in the form workspace_id/scope/principal",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "OVH Public Cloud project ID. Defaults to the provider ovh_project_id",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "Workspace ID",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace_url": workspaceURLAttribute(),
+			"scope": schema.StringAttribute{
+				Description: "Name of the secret scope",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal": schema.StringAttribute{
+				Description: "User or group the permission is granted to",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission": schema.StringAttribute{
+				Description: "Permission granted to the principal: READ, WRITE or MANAGE",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						client.SecretACLPermissionRead,
+						client.SecretACLPermissionWrite,
+						client.SecretACLPermissionManage,
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *DatabricksSecretACLResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DatabricksSecretACLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatabricksSecretACLResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := r.api(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "creating databricks secret ACL resource")
+
+	err := api.PutSecretACL(ctx, data.Scope.ValueString(), &client.SecretACL{
+		Principal:  data.Principal.ValueString(),
+		Permission: data.Permission.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "create secret ACL", err)
+		return
+	}
+
+	data.ID = types.StringValue(strings.Join([]string{data.WorkspaceID.ValueString(), data.Scope.ValueString(), data.Principal.ValueString()}, "/"))
+
+	tflog.Trace(ctx, "created databricks secret ACL resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabricksSecretACLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatabricksSecretACLResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := r.api(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	acl, err := api.GetSecretACL(ctx, data.Scope.ValueString(), data.Principal.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "databricks secret ACL not found, removing from state", map[string]any{"id": data.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read secret ACL", err)
+		return
+	}
+
+	data.Permission = types.StringValue(acl.Permission)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabricksSecretACLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DatabricksSecretACLResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := r.api(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := api.PutSecretACL(ctx, data.Scope.ValueString(), &client.SecretACL{
+		Principal:  data.Principal.ValueString(),
+		Permission: data.Permission.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "update secret ACL", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabricksSecretACLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DatabricksSecretACLResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := r.api(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := api.DeleteSecretACL(ctx, data.Scope.ValueString(), data.Principal.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "delete secret ACL", err)
+		return
+	}
+}
+
+func (r *DatabricksSecretACLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importKeys := func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+		importStateWithKeys(ctx, req, resp, "workspace_id", "scope", "principal")
+	}
+	importStateWithWorkspaceURL(ctx, req, resp, importKeys, importKeys)
+}
+
+// api returns the client managing the ACL: the workspace API when
+// workspace_url is set, otherwise the OVH API of the project.
+func (r *DatabricksSecretACLResource) api(data *DatabricksSecretACLResourceModel) (secretACLAPI, diag.Diagnostics) {
+	if !data.WorkspaceURL.IsNull() {
+		return r.client.workspaceAPI(data.WorkspaceURL, &data.ProjectID)
+	}
+	api, diags := r.client.projectAPI(&data.ProjectID)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return api.Workspace(data.WorkspaceID.ValueString()), diags
+}
@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+)
+
+var _ resource.Resource = &DatabricksSecretResource{}
+var _ resource.ResourceWithImportState = &DatabricksSecretResource{}
+var _ resource.ResourceWithConfigValidators = &DatabricksSecretResource{}
+
+func NewDatabricksSecretResource() resource.Resource {
+	return &DatabricksSecretResource{}
+}
+
+type DatabricksSecretResource struct {
+	client *Config
+}
+
+type DatabricksSecretResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	ProjectID            types.String `tfsdk:"project_id"`
+	WorkspaceURL         types.String `tfsdk:"workspace_url"`
+	WorkspaceID          types.String `tfsdk:"workspace_id"`
+	Scope                types.String `tfsdk:"scope"`
+	Key                  types.String `tfsdk:"key"`
+	StringValue          types.String `tfsdk:"string_value"`
+	StringValueWO        types.String `tfsdk:"string_value_wo"`
+	StringValueWOVersion types.Int64  `tfsdk:"string_value_wo_version"`
+	LastUpdatedTimestamp types.Int64  `tfsdk:"last_updated_timestamp"`
+}
+
+func (r *DatabricksSecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret"
+}
+
+func (r *DatabricksSecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a secret of a Databricks secret scope on OVH infrastructure",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Secret identifier, in the form workspace_id/scope/key",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "OVH Public Cloud project ID. Defaults to the provider ovh_project_id",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "Workspace ID",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace_url": workspaceURLAttribute(),
+			"scope": schema.StringAttribute{
+				Description: "Name of the secret scope",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "Secret key",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"string_value": schema.StringAttribute{
+				Description: "Secret value. It is stored in the Terraform state; use string_value_wo to avoid that",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"string_value_wo": schema.StringAttribute{
+				Description: "Write-only secret value, never stored in the Terraform state. Requires Terraform 1.11 or later. Change string_value_wo_version to update the secret",
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"string_value_wo_version": schema.Int64Attribute{
+				Description: "Version of string_value_wo. Changing it writes the current string_value_wo to the secret",
+				Optional:    true,
+			},
+			"last_updated_timestamp": schema.Int64Attribute{
+				Description: "Time of the last update of the secret, in milliseconds since the epoch",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *DatabricksSecretResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("string_value"),
+			path.MatchRoot("string_value_wo"),
+		),
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("string_value_wo"),
+			path.MatchRoot("string_value_wo_version"),
+		),
+	}
+}
+
+func (r *DatabricksSecretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DatabricksSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatabricksSecretResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := r.api(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "creating databricks secret resource")
+
+	r.put(ctx, api, req.Config, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(strings.Join([]string{data.WorkspaceID.ValueString(), data.Scope.ValueString(), data.Key.ValueString()}, "/"))
+
+	tflog.Trace(ctx, "created databricks secret resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabricksSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatabricksSecretResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := r.api(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := api.GetSecret(ctx, data.Scope.ValueString(), data.Key.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "databricks secret not found, removing from state", map[string]any{"id": data.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read secret", err)
+		return
+	}
+
+	// The value cannot be read back. A secret overwritten outside of Terraform
+	// shows up as a newer timestamp; forgetting the value (or its version)
+	// makes the next plan write the configured one again.
+	if !data.LastUpdatedTimestamp.IsNull() && data.LastUpdatedTimestamp.ValueInt64() != secret.LastUpdatedTimestamp {
+		tflog.Warn(ctx, "databricks secret was updated outside of Terraform", map[string]any{"id": data.ID.ValueString()})
+
+		if !data.StringValue.IsNull() {
+			data.StringValue = types.StringNull()
+		}
+		if !data.StringValueWOVersion.IsNull() {
+			data.StringValueWOVersion = types.Int64Null()
+		}
+	}
+	data.LastUpdatedTimestamp = types.Int64Value(secret.LastUpdatedTimestamp)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabricksSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DatabricksSecretResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := r.api(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.put(ctx, api, req.Config, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabricksSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DatabricksSecretResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, diags := r.api(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := api.DeleteSecret(ctx, data.Scope.ValueString(), data.Key.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "delete secret", err)
+		return
+	}
+}
+
+func (r *DatabricksSecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importKeys := func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+		importStateWithKeys(ctx, req, resp, "workspace_id", "scope", "key")
+	}
+	importStateWithWorkspaceURL(ctx, req, resp, importKeys, importKeys)
+}
+
+// put writes the configured value to the secret and records the resulting
+// timestamp. The write-only value is only available in the configuration.
+func (r *DatabricksSecretResource) put(ctx context.Context, api secretAPI, config tfsdk.Config, data *DatabricksSecretResourceModel, diags *diag.Diagnostics) {
+	value := data.StringValue.ValueString()
+	if data.StringValue.IsNull() {
+		var wo types.String
+		diags.Append(config.GetAttribute(ctx, path.Root("string_value_wo"), &wo)...)
+		if diags.HasError() {
+			return
+		}
+		value = wo.ValueString()
+	}
+
+	scope, key := data.Scope.ValueString(), data.Key.ValueString()
+
+	err := api.PutSecret(ctx, scope, &client.SecretPutRequest{
+		Key:         key,
+		StringValue: value,
+	})
+	if err != nil {
+		addClientError(diags, "put secret", err)
+		return
+	}
+
+	secret, err := api.GetSecret(ctx, scope, key)
+	if err != nil {
+		addClientError(diags, "read secret", err)
+		return
+	}
+
+	data.LastUpdatedTimestamp = types.Int64Value(secret.LastUpdatedTimestamp)
+}
+
+// api returns the client managing the secret: the workspace API when
+// workspace_url is set, otherwise the OVH API of the project.
+func (r *DatabricksSecretResource) api(data *DatabricksSecretResourceModel) (secretAPI, diag.Diagnostics) {
+	if !data.WorkspaceURL.IsNull() {
+		return r.client.workspaceAPI(data.WorkspaceURL, &data.ProjectID)
+	}
+	api, diags := r.client.projectAPI(&data.ProjectID)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return api.Workspace(data.WorkspaceID.ValueString()), diags
+}
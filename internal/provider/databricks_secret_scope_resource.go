@@ -4,13 +4,13 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
 )
 
 var _ resource.Resource = &DatabricksSecretScopeResource{}
@@ -26,6 +26,7 @@ type DatabricksSecretScopeResource struct {
 
 type DatabricksSecretScopeResourceModel struct {
 	ID          types.String `tfsdk:"id"`
+	ProjectID   types.String `tfsdk:"project_id"`
 	WorkspaceID types.String `tfsdk:"workspace_id"`
 	Name        types.String `tfsdk:"name"`
 	ScopeID     types.String `tfsdk:"scope_id"`
@@ -47,6 +48,15 @@ func (r *DatabricksSecretScopeResource) Schema(ctx context.Context, req resource
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"project_id": schema.StringAttribute{
+				Description: "OVH Public Cloud project ID. Defaults to the provider ovh_project_id",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"workspace_id": schema.StringAttribute{
 				Description: "Workspace ID",
 				Required:    true,
@@ -95,29 +105,25 @@ func (r *DatabricksSecretScopeResource) Create(ctx context.Context, req resource
 		return
 	}
 
-	tflog.Trace(ctx, "creating databricks secret scope resource")
-
-	scopeConfig := map[string]interface{}{
-		"workspaceId": data.WorkspaceID.ValueString(),
-		"name":        data.Name.ValueString(),
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	data.ProjectID = types.StringValue(projectID)
 
-	var result map[string]interface{}
-	err := r.client.OVHClient.Post("/cloud/project/databricks/secret-scope", scopeConfig, &result)
+	tflog.Trace(ctx, "creating databricks secret scope resource")
+
+	scope, err := r.client.API.Project(projectID).CreateSecretScope(ctx, &client.SecretScopeCreateRequest{
+		WorkspaceID: data.WorkspaceID.ValueString(),
+		Name:        data.Name.ValueString(),
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create secret scope, got error: %s", err))
+		addClientError(&resp.Diagnostics, "create secret scope", err)
 		return
 	}
 
-	scopeId := result["id"].(string)
-	data.ID = types.StringValue(scopeId)
-
-	if databricksScopeId, ok := result["scopeId"].(string); ok {
-		data.ScopeID = types.StringValue(databricksScopeId)
-	}
-	if createdTime, ok := result["createdTime"].(string); ok {
-		data.CreatedTime = types.StringValue(createdTime)
-	}
+	data.refresh(scope)
 
 	tflog.Trace(ctx, "created databricks secret scope resource")
 
@@ -132,26 +138,26 @@ func (r *DatabricksSecretScopeResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
-	var scope map[string]interface{}
-	err := r.client.OVHClient.Get(fmt.Sprintf("/cloud/project/databricks/secret-scope/%s", data.ID.ValueString()), &scope)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret scope, got error: %s", err))
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	data.ProjectID = types.StringValue(projectID)
 
-	if workspaceId, ok := scope["workspaceId"].(string); ok {
-		data.WorkspaceID = types.StringValue(workspaceId)
-	}
-	if name, ok := scope["name"].(string); ok {
-		data.Name = types.StringValue(name)
-	}
-	if scopeId, ok := scope["scopeId"].(string); ok {
-		data.ScopeID = types.StringValue(scopeId)
+	scope, err := r.client.API.Project(projectID).GetSecretScope(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "databricks secret scope not found, removing from state", map[string]any{"id": data.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
 	}
-	if createdTime, ok := scope["createdTime"].(string); ok {
-		data.CreatedTime = types.StringValue(createdTime)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read secret scope", err)
+		return
 	}
 
+	data.refresh(scope)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -163,16 +169,31 @@ func (r *DatabricksSecretScopeResource) Update(ctx context.Context, req resource
 		return
 	}
 
-	updateConfig := map[string]interface{}{
-		"name": data.Name.ValueString(),
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	data.ProjectID = types.StringValue(projectID)
+
+	api := r.client.API.Project(projectID)
 
-	err := r.client.OVHClient.Put(fmt.Sprintf("/cloud/project/databricks/secret-scope/%s", data.ID.ValueString()), updateConfig, nil)
+	err := api.UpdateSecretScope(ctx, data.ID.ValueString(), &client.SecretScopeUpdateRequest{
+		Name: data.Name.ValueString(),
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update secret scope, got error: %s", err))
+		addClientError(&resp.Diagnostics, "update secret scope", err)
 		return
 	}
 
+	scope, err := api.GetSecretScope(ctx, data.ID.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read secret scope", err)
+		return
+	}
+
+	data.refresh(scope)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -184,13 +205,34 @@ func (r *DatabricksSecretScopeResource) Delete(ctx context.Context, req resource
 		return
 	}
 
-	err := r.client.OVHClient.Delete(fmt.Sprintf("/cloud/project/databricks/secret-scope/%s", data.ID.ValueString()), nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete secret scope, got error: %s", err))
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.API.Project(projectID).DeleteSecretScope(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		addClientError(&resp.Diagnostics, "delete secret scope", err)
 		return
 	}
 }
 
 func (r *DatabricksSecretScopeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateWithProject(ctx, req, resp)
+}
+
+// refresh copies the API representation of a secret scope into the model.
+func (data *DatabricksSecretScopeResourceModel) refresh(scope *client.SecretScope) {
+	data.ID = types.StringValue(scope.ID.String())
+
+	if scope.WorkspaceID != "" {
+		data.WorkspaceID = types.StringValue(scope.WorkspaceID)
+	}
+	if scope.Name != "" {
+		data.Name = types.StringValue(scope.Name)
+	}
+
+	data.ScopeID = types.StringValue(scope.ScopeID.String())
+	data.CreatedTime = types.StringValue(scope.CreatedTime.String())
 }
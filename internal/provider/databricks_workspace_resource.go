@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -13,6 +15,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+)
+
+const (
+	workspaceCreateTimeout = 45 * time.Minute
+	workspaceReadTimeout   = 5 * time.Minute
+	workspaceUpdateTimeout = 45 * time.Minute
+	workspaceDeleteTimeout = 30 * time.Minute
 )
 
 var _ resource.Resource = &DatabricksWorkspaceResource{}
@@ -27,24 +37,26 @@ type DatabricksWorkspaceResource struct {
 }
 
 type DatabricksWorkspaceResourceModel struct {
-	ID                       types.String `tfsdk:"id"`
-	Name                     types.String `tfsdk:"name"`
-	Region                   types.String `tfsdk:"region"`
-	Tier                     types.String `tfsdk:"tier"`
-	DeploymentName           types.String `tfsdk:"deployment_name"`
-	AWSRegion                types.String `tfsdk:"aws_region"`
-	CredentialsID            types.String `tfsdk:"credentials_id"`
-	StorageConfigurationID   types.String `tfsdk:"storage_configuration_id"`
-	NetworkID                types.String `tfsdk:"network_id"`
-	CustomerManagedKeyID     types.String `tfsdk:"customer_managed_key_id"`
-	PricingTier              types.String `tfsdk:"pricing_tier"`
-	CustomTags               types.Map    `tfsdk:"custom_tags"`
-	OVHOptimization          types.Bool   `tfsdk:"ovh_optimization"`
-	CostTracking             types.Bool   `tfsdk:"cost_tracking"`
-	WorkspaceID              types.String `tfsdk:"workspace_id"`
-	WorkspaceURL             types.String `tfsdk:"workspace_url"`
-	WorkspaceStatus          types.String `tfsdk:"workspace_status"`
-	CreationTime             types.String `tfsdk:"creation_time"`
+	ID                     types.String   `tfsdk:"id"`
+	ProjectID              types.String   `tfsdk:"project_id"`
+	Name                   types.String   `tfsdk:"name"`
+	Region                 types.String   `tfsdk:"region"`
+	Tier                   types.String   `tfsdk:"tier"`
+	DeploymentName         types.String   `tfsdk:"deployment_name"`
+	AWSRegion              types.String   `tfsdk:"aws_region"`
+	CredentialsID          types.String   `tfsdk:"credentials_id"`
+	StorageConfigurationID types.String   `tfsdk:"storage_configuration_id"`
+	NetworkID              types.String   `tfsdk:"network_id"`
+	CustomerManagedKeyID   types.String   `tfsdk:"customer_managed_key_id"`
+	PricingTier            types.String   `tfsdk:"pricing_tier"`
+	CustomTags             types.Map      `tfsdk:"custom_tags"`
+	OVHOptimization        types.Bool     `tfsdk:"ovh_optimization"`
+	CostTracking           types.Bool     `tfsdk:"cost_tracking"`
+	WorkspaceID            types.String   `tfsdk:"workspace_id"`
+	WorkspaceURL           types.String   `tfsdk:"workspace_url"`
+	WorkspaceStatus        types.String   `tfsdk:"workspace_status"`
+	CreationTime           types.String   `tfsdk:"creation_time"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *DatabricksWorkspaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -62,6 +74,15 @@ func (r *DatabricksWorkspaceResource) Schema(ctx context.Context, req resource.S
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"project_id": schema.StringAttribute{
+				Description: "OVH Public Cloud project ID. Defaults to the provider ovh_project_id",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"name": schema.StringAttribute{
 				Description: "Workspace name",
 				Required:    true,
@@ -145,6 +166,14 @@ func (r *DatabricksWorkspaceResource) Schema(ctx context.Context, req resource.S
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -173,41 +202,76 @@ func (r *DatabricksWorkspaceResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ProjectID = types.StringValue(projectID)
+
 	tflog.Trace(ctx, "creating databricks workspace resource")
 
-	workspaceConfig := map[string]interface{}{
-		"name":                     data.Name.ValueString(),
-		"region":                   data.Region.ValueString(),
-		"tier":                     data.Tier.ValueString(),
-		"deploymentName":           data.DeploymentName.ValueString(),
-		"awsRegion":                data.AWSRegion.ValueString(),
-		"credentialsId":            data.CredentialsID.ValueString(),
-		"storageConfigurationId":   data.StorageConfigurationID.ValueString(),
-		"networkId":                data.NetworkID.ValueString(),
-		"customerManagedKeyId":     data.CustomerManagedKeyID.ValueString(),
-		"pricingTier":              data.PricingTier.ValueString(),
-		"ovhOptimization":          data.OVHOptimization.ValueBool(),
-		"costTracking":             data.CostTracking.ValueBool(),
+	createReq := &client.WorkspaceCreateRequest{
+		Name:                   data.Name.ValueString(),
+		Region:                 data.Region.ValueString(),
+		Tier:                   data.Tier.ValueString(),
+		DeploymentName:         data.DeploymentName.ValueString(),
+		AWSRegion:              data.AWSRegion.ValueString(),
+		CredentialsID:          data.CredentialsID.ValueString(),
+		StorageConfigurationID: data.StorageConfigurationID.ValueString(),
+		NetworkID:              data.NetworkID.ValueString(),
+		CustomerManagedKeyID:   data.CustomerManagedKeyID.ValueString(),
+		PricingTier:            data.PricingTier.ValueString(),
+		OVHOptimization:        data.OVHOptimization.ValueBool(),
+		CostTracking:           data.CostTracking.ValueBool(),
 	}
 
 	if !data.CustomTags.IsNull() {
-		var tags map[string]string
-		resp.Diagnostics.Append(data.CustomTags.ElementsAs(ctx, &tags, false)...)
+		resp.Diagnostics.Append(data.CustomTags.ElementsAs(ctx, &createReq.CustomTags, false)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		workspaceConfig["customTags"] = tags
 	}
 
-	var result map[string]interface{}
-	err := r.client.OVHClient.Post("/cloud/project/databricks/workspace", workspaceConfig, &result)
+	createTimeout, diags := data.Timeouts.Create(ctx, workspaceCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	api := r.client.API.Project(projectID)
+
+	workspace, err := api.CreateWorkspace(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workspace, got error: %s", err))
+		addClientError(&resp.Diagnostics, "create workspace", err)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, workspace)...)
+
+	// Save the identifier before waiting so that a workspace which fails to
+	// provision is tracked (and tainted) rather than orphaned.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	workspaceId := result["id"].(string)
-	data.ID = types.StringValue(workspaceId)
+	tflog.Debug(ctx, "waiting for databricks workspace to be running", map[string]any{"id": data.ID.ValueString()})
+
+	workspace, err = api.WaitForWorkspaceRunning(ctx, data.ID.ValueString())
+	if workspace != nil {
+		resp.Diagnostics.Append(data.refresh(ctx, workspace)...)
+	}
+	if err != nil {
+		if !addContextError(&resp.Diagnostics, "provision workspace "+data.ID.ValueString(), err) {
+			resp.Diagnostics.AddError("Workspace Provisioning Error", fmt.Sprintf("Workspace %s did not become RUNNING: %s", data.ID.ValueString(), err))
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
 
 	tflog.Trace(ctx, "created databricks workspace resource")
 
@@ -222,113 +286,119 @@ func (r *DatabricksWorkspaceResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	var workspace map[string]interface{}
-	err := r.client.OVHClient.Get(fmt.Sprintf("/cloud/project/databricks/workspace/%s", data.ID.ValueString()), &workspace)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspace, got error: %s", err))
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if name, ok := workspace["name"].(string); ok {
-		data.Name = types.StringValue(name)
-	}
-	if region, ok := workspace["region"].(string); ok {
-		data.Region = types.StringValue(region)
-	}
-	if tier, ok := workspace["tier"].(string); ok {
-		data.Tier = types.StringValue(tier)
-	}
-	if deploymentName, ok := workspace["deploymentName"].(string); ok {
-		data.DeploymentName = types.StringValue(deploymentName)
-	}
-	if awsRegion, ok := workspace["awsRegion"].(string); ok {
-		data.AWSRegion = types.StringValue(awsRegion)
-	}
-	if credentialsId, ok := workspace["credentialsId"].(string); ok {
-		data.CredentialsID = types.StringValue(credentialsId)
-	}
-	if storageConfigurationId, ok := workspace["storageConfigurationId"].(string); ok {
-		data.StorageConfigurationID = types.StringValue(storageConfigurationId)
-	}
-	if networkId, ok := workspace["networkId"].(string); ok {
-		data.NetworkID = types.StringValue(networkId)
-	}
-	if customerManagedKeyId, ok := workspace["customerManagedKeyId"].(string); ok {
-		data.CustomerManagedKeyID = types.StringValue(customerManagedKeyId)
-	}
-	if pricingTier, ok := workspace["pricingTier"].(string); ok {
-		data.PricingTier = types.StringValue(pricingTier)
-	}
-	if ovhOptimization, ok := workspace["ovhOptimization"].(bool); ok {
-		data.OVHOptimization = types.BoolValue(ovhOptimization)
-	}
-	if costTracking, ok := workspace["costTracking"].(bool); ok {
-		data.CostTracking = types.BoolValue(costTracking)
-	}
-	if workspaceId, ok := workspace["workspaceId"].(string); ok {
-		data.WorkspaceID = types.StringValue(workspaceId)
-	}
-	if workspaceUrl, ok := workspace["workspaceUrl"].(string); ok {
-		data.WorkspaceURL = types.StringValue(workspaceUrl)
+	readTimeout, diags := data.Timeouts.Read(ctx, workspaceReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if workspaceStatus, ok := workspace["workspaceStatus"].(string); ok {
-		data.WorkspaceStatus = types.StringValue(workspaceStatus)
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+	data.ProjectID = types.StringValue(projectID)
+
+	workspace, err := r.client.API.Project(projectID).GetWorkspace(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "databricks workspace not found, removing from state", map[string]any{"id": data.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
 	}
-	if creationTime, ok := workspace["creationTime"].(string); ok {
-		data.CreationTime = types.StringValue(creationTime)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "read workspace", err)
+		return
 	}
 
-	if tags, ok := workspace["customTags"].(map[string]interface{}); ok {
-		tagMap := make(map[string]string)
-		for k, v := range tags {
-			if str, ok := v.(string); ok {
-				tagMap[k] = str
-			}
-		}
-		tagsValue, diags := types.MapValueFrom(ctx, types.StringType, tagMap)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.CustomTags = tagsValue
-		}
+	// The tags of an imported workspace are all kept, as if configured.
+	if readingImport(ctx, req, resp) {
+		data.CustomTags = stringsToMap(ctx, workspace.CustomTags, &resp.Diagnostics)
 	}
 
+	resp.Diagnostics.Append(data.refresh(ctx, workspace)...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DatabricksWorkspaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data DatabricksWorkspaceResourceModel
+	var data, state DatabricksWorkspaceResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updateConfig := map[string]interface{}{}
-
-	if !data.Name.IsNull() {
-		updateConfig["name"] = data.Name.ValueString()
-	}
-	if !data.Tier.IsNull() {
-		updateConfig["tier"] = data.Tier.ValueString()
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if !data.PricingTier.IsNull() {
-		updateConfig["pricingTier"] = data.PricingTier.ValueString()
+	data.ProjectID = types.StringValue(projectID)
+
+	api := r.client.API.Project(projectID)
+
+	updateReq := &client.WorkspaceUpdateRequest{
+		Name:        data.Name.ValueString(),
+		Tier:        data.Tier.ValueString(),
+		PricingTier: data.PricingTier.ValueString(),
 	}
+
 	if !data.CustomTags.IsNull() {
-		var tags map[string]string
-		resp.Diagnostics.Append(data.CustomTags.ElementsAs(ctx, &tags, false)...)
+		resp.Diagnostics.Append(data.CustomTags.ElementsAs(ctx, &updateReq.CustomTags, false)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		updateConfig["customTags"] = tags
+	} else if !state.CustomTags.IsNull() {
+		// The tags were removed from the configuration.
+		updateReq.CustomTags = map[string]string{}
 	}
 
-	err := r.client.OVHClient.Put(fmt.Sprintf("/cloud/project/databricks/workspace/%s", data.ID.ValueString()), updateConfig, nil)
+	updateTimeout, diags := data.Timeouts.Update(ctx, workspaceUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	err := api.UpdateWorkspace(ctx, data.ID.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update workspace, got error: %s", err))
+		addClientError(&resp.Diagnostics, "update workspace", err)
 		return
 	}
 
+	var workspace *client.Workspace
+	if !data.Tier.Equal(state.Tier) || !data.PricingTier.Equal(state.PricingTier) {
+		tflog.Debug(ctx, "waiting for databricks workspace tier change", map[string]any{"id": data.ID.ValueString()})
+
+		workspace, err = api.WaitForWorkspaceRunning(ctx, data.ID.ValueString())
+		if err != nil {
+			if !addContextError(&resp.Diagnostics, "update workspace "+data.ID.ValueString(), err) {
+				resp.Diagnostics.AddError("Workspace Update Error", fmt.Sprintf("Workspace %s did not return to RUNNING: %s", data.ID.ValueString(), err))
+			}
+			// The update itself succeeded, so save the workspace as the
+			// API last returned it rather than the prior state.
+			if workspace != nil {
+				resp.Diagnostics.Append(data.refresh(ctx, workspace)...)
+			}
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	} else {
+		workspace, err = api.GetWorkspace(ctx, data.ID.ValueString())
+		if err != nil {
+			addClientError(&resp.Diagnostics, "read workspace", err)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(data.refresh(ctx, workspace)...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -340,13 +410,95 @@ func (r *DatabricksWorkspaceResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	err := r.client.OVHClient.Delete(fmt.Sprintf("/cloud/project/databricks/workspace/%s", data.ID.ValueString()), nil)
+	projectID, diags := r.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, workspaceDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	api := r.client.API.Project(projectID)
+
+	err := api.DeleteWorkspace(ctx, data.ID.ValueString())
+	if client.IsNotFound(err) {
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete workspace, got error: %s", err))
+		addClientError(&resp.Diagnostics, "delete workspace", err)
+		return
+	}
+
+	if err := api.WaitForWorkspaceDeleted(ctx, data.ID.ValueString()); err != nil {
+		if !addContextError(&resp.Diagnostics, "delete workspace "+data.ID.ValueString(), err) {
+			resp.Diagnostics.AddError("Workspace Deletion Error", fmt.Sprintf("Workspace %s was not deleted: %s", data.ID.ValueString(), err))
+		}
 		return
 	}
 }
 
 func (r *DatabricksWorkspaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateWithProject(ctx, req, resp)
+	markImported(ctx, resp)
+}
+
+// refresh copies the API representation of a workspace into the model. Fields
+// the API leaves empty keep their planned or prior value.
+func (data *DatabricksWorkspaceResourceModel) refresh(ctx context.Context, workspace *client.Workspace) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(workspace.ID.String())
+
+	if workspace.Name != "" {
+		data.Name = types.StringValue(workspace.Name)
+	}
+	if workspace.Region != "" {
+		data.Region = types.StringValue(workspace.Region)
+	}
+	if workspace.Tier != "" {
+		data.Tier = types.StringValue(workspace.Tier)
+	}
+	if workspace.DeploymentName != "" || data.DeploymentName.IsUnknown() {
+		data.DeploymentName = types.StringValue(workspace.DeploymentName)
+	}
+	if workspace.AWSRegion != "" || data.AWSRegion.IsUnknown() {
+		data.AWSRegion = types.StringValue(workspace.AWSRegion)
+	}
+	if workspace.CredentialsID != "" {
+		data.CredentialsID = types.StringValue(workspace.CredentialsID)
+	}
+	if workspace.StorageConfigurationID != "" {
+		data.StorageConfigurationID = types.StringValue(workspace.StorageConfigurationID)
+	}
+	if workspace.NetworkID != "" {
+		data.NetworkID = types.StringValue(workspace.NetworkID)
+	}
+	if workspace.CustomerManagedKeyID != "" {
+		data.CustomerManagedKeyID = types.StringValue(workspace.CustomerManagedKeyID)
+	}
+	if workspace.PricingTier != "" {
+		data.PricingTier = types.StringValue(workspace.PricingTier)
+	}
+	if workspace.OVHOptimization != nil {
+		data.OVHOptimization = types.BoolValue(*workspace.OVHOptimization)
+	}
+	if workspace.CostTracking != nil {
+		data.CostTracking = types.BoolValue(*workspace.CostTracking)
+	}
+
+	data.WorkspaceID = types.StringValue(workspace.WorkspaceID.String())
+	data.WorkspaceURL = types.StringValue(workspace.WorkspaceURL)
+	data.WorkspaceStatus = types.StringValue(workspace.WorkspaceStatus)
+	data.CreationTime = types.StringValue(workspace.CreationTime.String())
+
+	data.CustomTags = refreshStringMap(ctx, data.CustomTags, workspace.CustomTags, &diags)
+
+	return diags
 }
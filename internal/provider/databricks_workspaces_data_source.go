@@ -22,6 +22,7 @@ type DatabricksWorkspacesDataSource struct {
 
 type DatabricksWorkspacesDataSourceModel struct {
 	ID         types.String                         `tfsdk:"id"`
+	ProjectID  types.String                         `tfsdk:"project_id"`
 	Region     types.String                         `tfsdk:"region"`
 	Status     types.String                         `tfsdk:"status"`
 	Workspaces []DatabricksWorkspaceDataSourceModel `tfsdk:"workspaces"`
@@ -50,6 +51,11 @@ func (d *DatabricksWorkspacesDataSource) Schema(ctx context.Context, req datasou
 				Description: "Data source identifier",
 				Computed:    true,
 			},
+			"project_id": schema.StringAttribute{
+				Description: "OVH Public Cloud project ID. Defaults to the provider ovh_project_id",
+				Optional:    true,
+				Computed:    true,
+			},
 			"region": schema.StringAttribute{
 				Description: "Filter workspaces by OVH region",
 				Optional:    true,
@@ -127,42 +133,31 @@ func (d *DatabricksWorkspacesDataSource) Read(ctx context.Context, req datasourc
 		return
 	}
 
-	tflog.Debug(ctx, "Reading Databricks workspaces")
+	projectID, diags := d.client.projectID(data.ProjectID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Databricks workspaces", map[string]any{"project_id": projectID})
 
-	var workspaces []map[string]interface{}
-	err := d.client.OVHClient.Get("/cloud/project/databricks/workspace", &workspaces)
+	workspaces, err := d.client.API.Project(projectID).ListWorkspaces(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspaces, got error: %s", err))
+		addClientError(&resp.Diagnostics, "read workspaces", err)
 		return
 	}
 
 	var filteredWorkspaces []DatabricksWorkspaceDataSourceModel
 	for _, workspace := range workspaces {
-		workspaceModel := DatabricksWorkspaceDataSourceModel{}
-
-		if id, ok := workspace["id"].(string); ok {
-			workspaceModel.ID = types.StringValue(id)
-		}
-		if name, ok := workspace["name"].(string); ok {
-			workspaceModel.Name = types.StringValue(name)
-		}
-		if region, ok := workspace["region"].(string); ok {
-			workspaceModel.Region = types.StringValue(region)
-		}
-		if tier, ok := workspace["tier"].(string); ok {
-			workspaceModel.Tier = types.StringValue(tier)
-		}
-		if workspaceId, ok := workspace["workspaceId"].(string); ok {
-			workspaceModel.WorkspaceID = types.StringValue(workspaceId)
-		}
-		if workspaceUrl, ok := workspace["workspaceUrl"].(string); ok {
-			workspaceModel.WorkspaceURL = types.StringValue(workspaceUrl)
-		}
-		if status, ok := workspace["status"].(string); ok {
-			workspaceModel.Status = types.StringValue(status)
-		}
-		if createdTime, ok := workspace["createdTime"].(string); ok {
-			workspaceModel.CreatedTime = types.StringValue(createdTime)
+		workspaceModel := DatabricksWorkspaceDataSourceModel{
+			ID:           types.StringValue(workspace.ID.String()),
+			Name:         types.StringValue(workspace.Name),
+			Region:       types.StringValue(workspace.Region),
+			Tier:         types.StringValue(workspace.Tier),
+			WorkspaceID:  types.StringValue(workspace.WorkspaceID.String()),
+			WorkspaceURL: types.StringValue(workspace.WorkspaceURL),
+			Status:       types.StringValue(workspace.Status),
+			CreatedTime:  types.StringValue(workspace.CreatedTime.String()),
 		}
 
 		if !data.Region.IsNull() && !data.Region.IsUnknown() {
@@ -181,7 +176,8 @@ func (d *DatabricksWorkspacesDataSource) Read(ctx context.Context, req datasourc
 	}
 
 	data.Workspaces = filteredWorkspaces
-	data.ID = types.StringValue("workspaces")
+	data.ID = types.StringValue(projectID + "/workspaces")
+	data.ProjectID = types.StringValue(projectID)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/databricks"
+)
+
+// requiredOVHAccessRules are the access rules the consumer key of the
+// provider needs on the OVH API.
+var requiredOVHAccessRules = []string{
+	"GET /cloud/project/*/databricks/*",
+	"POST /cloud/project/*/databricks/*",
+	"PUT /cloud/project/*/databricks/*",
+	"DELETE /cloud/project/*/databricks/*",
+}
+
+// ovhFieldPattern matches the field OVH validation errors start with, as in
+// "[nodeTypeId] Given data (x) does not belong to the NodeType enumeration"
+// or "[ovhAttributes.billingPeriod] ...".
+var ovhFieldPattern = regexp.MustCompile(`^\[(\S+)\](?:\s|$)`)
+
+// ovhFieldAttributes maps the root fields of the OVH API requests to the
+// root attribute they come from in every resource sending them. Errors about
+// other fields, such as nested ones, are not attached to an attribute.
+var ovhFieldAttributes = map[string]string{
+	"serviceName": "project_id",
+	"workspaceId": "workspace_id",
+	"name":        "name",
+	"customTags":  "custom_tags",
+
+	// Workspaces.
+	"region":                 "region",
+	"tier":                   "tier",
+	"pricingTier":            "pricing_tier",
+	"deploymentName":         "deployment_name",
+	"awsRegion":              "aws_region",
+	"credentialsId":          "credentials_id",
+	"storageConfigurationId": "storage_configuration_id",
+	"networkId":              "network_id",
+	"customerManagedKeyId":   "customer_managed_key_id",
+	"ovhOptimization":        "ovh_optimization",
+	"costTracking":           "cost_tracking",
+
+	// Clusters and instance pools.
+	"clusterName":                        "cluster_name",
+	"sparkVersion":                       "spark_version",
+	"nodeTypeId":                         "node_type_id",
+	"driverNodeTypeId":                   "driver_node_type_id",
+	"numWorkers":                         "num_workers",
+	"autoterminationMinutes":             "autotermination_minutes",
+	"sparkConf":                          "spark_conf",
+	"policyId":                           "policy_id",
+	"minIdleInstances":                   "min_idle_instances",
+	"maxCapacity":                        "max_capacity",
+	"idleInstanceAutoterminationMinutes": "idle_instance_autotermination_minutes",
+	"enableElasticDisk":                  "enable_elastic_disk",
+	"preloadedSparkVersions":             "preloaded_spark_versions",
+
+	// Cluster policies.
+	"definition":                      "definition",
+	"description":                     "description",
+	"maxClustersPerUser":              "max_clusters_per_user",
+	"policyFamilyId":                  "policy_family_id",
+	"policyFamilyDefinitionOverrides": "policy_family_definition_overrides",
+
+	// Jobs and job runs.
+	"existingClusterId":      "existing_cluster_id",
+	"timeoutSeconds":         "timeout_seconds",
+	"maxRetries":             "max_retries",
+	"minRetryIntervalMillis": "min_retry_interval_millis",
+	"retryOnTimeout":         "retry_on_timeout",
+	"maxConcurrentRuns":      "max_concurrent_runs",
+	"tags":                   "tags",
+	"notebookParams":         "notebook_params",
+	"pythonParams":           "python_params",
+
+	// Notebooks, secrets and secret ACLs.
+	"path":       "path",
+	"language":   "language",
+	"content":    "content",
+	"format":     "format",
+	"key":        "key",
+	"principal":  "principal",
+	"permission": "permission",
+}
+
+// addClientError adds the error diagnostic of a failed API call, where action
+// describes the call, such as "create cluster". API errors are unpacked so
+// that the status, error class and OVH query ID are shown, and validation
+// errors are attached to the attribute they are about.
+func addClientError(diags *diag.Diagnostics, action string, err error) {
+	if addContextError(diags, action, err) {
+		return
+	}
+
+	var ovhErr *ovh.APIError
+	if errors.As(err, &ovhErr) {
+		addOVHAPIError(diags, action, err, ovhErr)
+		return
+	}
+
+	var databricksErr *databricks.APIError
+	if errors.As(err, &databricksErr) {
+		addDatabricksAPIError(diags, action, err, databricksErr)
+		return
+	}
+
+	diags.AddError("Client Error", fmt.Sprintf("Unable to %s, got error: %s", action, err))
+}
+
+// addContextError adds a diagnostic and reports true when err comes from the
+// context of the operation: Terraform cancels it when interrupted, and it
+// expires with the timeouts of the resource. Such errors are not API
+// failures and would be confusing as a "Client Error".
+func addContextError(diags *diag.Diagnostics, action string, err error) bool {
+	switch {
+	case errors.Is(err, context.Canceled):
+		diags.AddError(
+			"Operation Cancelled",
+			fmt.Sprintf("Unable to %s: the operation was cancelled, usually because Terraform was interrupted. "+
+				"The remote object may have been left in an intermediate state; run terraform apply again to reconcile it.", action),
+		)
+		return true
+	case errors.Is(err, context.DeadlineExceeded):
+		diags.AddError(
+			"Operation Timed Out",
+			fmt.Sprintf("Unable to %s before the timeout of the operation expired. "+
+				"The remote object may still be changing; increase the timeouts of the resource if it needs more time.", action),
+		)
+		return true
+	default:
+		return false
+	}
+}
+
+func addOVHAPIError(diags *diag.Diagnostics, action string, err error, apiErr *ovh.APIError) {
+	var attribute string
+	if m := ovhFieldPattern.FindStringSubmatch(apiErr.Message); m != nil {
+		attribute = ovhFieldAttributes[m[1]]
+	}
+
+	var detail strings.Builder
+	fmt.Fprintf(&detail, "Unable to %s, the OVH API returned: %s\n", action, apiErr.Message)
+	detail.WriteString(requestDetails(err, apiErr.Code, apiErr.Class))
+	if apiErr.QueryID != "" {
+		fmt.Fprintf(&detail, "\nQuery ID: %s (include it when contacting OVHcloud support)", apiErr.QueryID)
+	}
+
+	switch {
+	case apiErr.Code == http.StatusForbidden:
+		detail.WriteString("\n\n" + ovhPermissionHint(apiErr))
+		diags.AddError("Insufficient OVH API Permissions", detail.String())
+	case attribute != "" && apiErr.Code >= 400 && apiErr.Code < 500:
+		diags.AddAttributeError(path.Root(attribute), "Invalid Attribute Value", detail.String())
+	default:
+		diags.AddError("OVH API Error", detail.String())
+	}
+}
+
+// ovhPermissionHint explains which access rules or IAM actions the
+// credentials are missing.
+func ovhPermissionHint(apiErr *ovh.APIError) string {
+	var hint strings.Builder
+	for _, key := range []string{"unauthorizedActionsByAuthentication", "unauthorizedActionsByIAM"} {
+		if actions := apiErr.Details[key]; actions != "" {
+			fmt.Fprintf(&hint, "Missing actions: %s\n\n", actions)
+			break
+		}
+	}
+	hint.WriteString("The consumer key configured with ovh_consumer_key must be created with the following access rules:\n")
+	for _, rule := range requiredOVHAccessRules {
+		hint.WriteString("  - " + rule + "\n")
+	}
+	hint.WriteString("OAuth2 service accounts (ovh_client_id) need an IAM policy granting the same operations on the project.")
+	return hint.String()
+}
+
+func addDatabricksAPIError(diags *diag.Diagnostics, action string, err error, apiErr *databricks.APIError) {
+	detail := fmt.Sprintf("Unable to %s, the Databricks API returned: %s\n", action, apiErr.Message) +
+		requestDetails(err, apiErr.StatusCode, apiErr.ErrorCode)
+
+	summary := "Databricks API Error"
+	if apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden {
+		summary = "Insufficient Databricks Permissions"
+	}
+	diags.AddError(summary, detail)
+}
+
+// requestDetails describes the failed request and its status for the detail
+// of a diagnostic.
+func requestDetails(err error, status int, class string) string {
+	var details strings.Builder
+
+	var clientErr *client.Error
+	if errors.As(err, &clientErr) {
+		fmt.Fprintf(&details, "\nRequest: %s %s", clientErr.Method, clientErr.Path)
+	}
+
+	fmt.Fprintf(&details, "\nHTTP status: %d %s", status, http.StatusText(status))
+	if class != "" {
+		fmt.Fprintf(&details, " (%s)", class)
+	}
+	return details.String()
+}
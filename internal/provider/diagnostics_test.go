@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/databricks"
+)
+
+func TestAddClientError(t *testing.T) {
+	tests := map[string]struct {
+		err        error
+		want       string
+		wantPath   path.Path
+		wantDetail string
+	}{
+		"cancelled": {
+			err:  &client.Error{Method: "GET", Path: "/x", Err: &url.Error{Op: "Get", URL: "/x", Err: context.Canceled}},
+			want: "Operation Cancelled",
+		},
+		"timed out": {
+			err:  &client.Error{Method: "GET", Path: "/x", Err: &url.Error{Op: "Get", URL: "/x", Err: context.DeadlineExceeded}},
+			want: "Operation Timed Out",
+		},
+		"validation": {
+			err: &client.Error{Method: "POST", Path: "/cloud/project/p/databricks/cluster", Err: &ovh.APIError{
+				Code:    400,
+				Class:   "Client::BadRequest",
+				Message: "[nodeTypeId] Given data (b9-999) does not belong to the NodeType enumeration",
+				QueryID: "EU.ext-1.abc",
+			}},
+			want:       "Invalid Attribute Value",
+			wantPath:   path.Root("node_type_id"),
+			wantDetail: "Query ID: EU.ext-1.abc",
+		},
+		"validation of a workspace field": {
+			err: &client.Error{Method: "POST", Path: "/cloud/project/p/databricks/workspace", Err: &ovh.APIError{
+				Code:    400,
+				Message: "[region] Given data (XX-1) does not belong to the Region enumeration",
+			}},
+			want:       "Invalid Attribute Value",
+			wantPath:   path.Root("region"),
+			wantDetail: "[region] Given data (XX-1)",
+		},
+		"validation of a nested field": {
+			err: &client.Error{Method: "POST", Path: "/cloud/project/p/databricks/instance-pool", Err: &ovh.APIError{
+				Code:    400,
+				Message: "[ovhAttributes.billingPeriod] Given data (DAILY) does not belong to the BillingPeriod enumeration",
+			}},
+			want:       "OVH API Error",
+			wantDetail: "[ovhAttributes.billingPeriod] Given data (DAILY)",
+		},
+		"validation of an unmapped field": {
+			err: &client.Error{Method: "POST", Path: "/cloud/project/p/databricks/job", Err: &ovh.APIError{
+				Code:    400,
+				Message: "[runAs] Given data (x) is not a valid user",
+			}},
+			want:       "OVH API Error",
+			wantDetail: "[runAs]",
+		},
+		"forbidden": {
+			err: &client.Error{Method: "GET", Path: "/x", Err: &ovh.APIError{
+				Code:    403,
+				Message: "This call has not been granted",
+				Details: map[string]string{"unauthorizedActionsByAuthentication": "publicCloudProject:apiovh:databricks/get"},
+			}},
+			want:       "Insufficient OVH API Permissions",
+			wantDetail: "DELETE /cloud/project/*/databricks/*",
+		},
+		"databricks": {
+			err:        &client.Error{Method: "GET", Path: "/x", Err: &databricks.APIError{StatusCode: 400, ErrorCode: "INVALID_PARAMETER_VALUE", Message: "bad"}},
+			want:       "Databricks API Error",
+			wantDetail: "HTTP status: 400 Bad Request (INVALID_PARAMETER_VALUE)",
+		},
+		"other error": {
+			err:  &client.Error{Method: "GET", Path: "/x", Err: errors.New("connection refused")},
+			want: "Client Error",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			addClientError(&diags, "read cluster", tt.err)
+
+			if len(diags) != 1 || diags[0].Summary() != tt.want {
+				t.Fatalf("got %v, want a single %q error", diags, tt.want)
+			}
+			if !strings.Contains(diags[0].Detail(), tt.wantDetail) {
+				t.Errorf("got detail %q, want it to contain %q", diags[0].Detail(), tt.wantDetail)
+			}
+
+			var gotPath path.Path
+			if withPath, ok := diags[0].(diag.DiagnosticWithPath); ok {
+				gotPath = withPath.Path()
+			}
+			if !gotPath.Equal(tt.wantPath) {
+				t.Errorf("got path %s, want %s", gotPath, tt.wantPath)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// defaultRequestTimeout is the timeout of an API request, as in go-ovh.
+const defaultRequestTimeout = ovh.DefaultTimeout
+
+// httpSettings configure the HTTP clients of the OVH and Databricks APIs.
+type httpSettings struct {
+	// Proxy, when set, replaces the proxy of the HTTPS_PROXY and
+	// HTTP_PROXY environment variables.
+	Proxy              *url.URL
+	CABundleFile       string
+	InsecureSkipVerify bool
+	Timeout            time.Duration
+}
+
+// resolveHTTPSettings validates the HTTP settings of the provider
+// configuration.
+func resolveHTTPSettings(config DatabricksOVHProviderModel) (httpSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	settings := httpSettings{
+		CABundleFile:       config.CABundleFile.ValueString(),
+		InsecureSkipVerify: config.InsecureSkipVerify.ValueBool(),
+		Timeout:            defaultRequestTimeout,
+	}
+
+	if v := config.HTTPProxy.ValueString(); v != "" {
+		u, err := url.Parse(v)
+		if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "socks5") {
+			diags.AddAttributeError(
+				path.Root("http_proxy"),
+				"Invalid HTTP Proxy",
+				fmt.Sprintf("http_proxy must be a URL such as \"http://proxy.example.com:3128\", with an http, https or socks5 scheme, got %q.", v),
+			)
+		}
+		settings.Proxy = u
+	}
+
+	if v := config.RequestTimeout.ValueString(); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			diags.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Request Timeout",
+				fmt.Sprintf("request_timeout must be a positive duration such as \"3m\", got %q.", v),
+			)
+		}
+		settings.Timeout = d
+	}
+
+	if settings.InsecureSkipVerify {
+		diags.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS Certificate Verification Disabled",
+			"The certificates of the OVH and Databricks APIs are not verified, so requests and credentials can be intercepted. "+
+				"Only use insecure_skip_verify against a local test server; use ca_bundle_file to trust a private certificate authority.",
+		)
+	}
+
+	return settings, diags
+}
+
+// transport returns the base transport of the API clients.
+func (s httpSettings) transport() (http.RoundTripper, error) {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+
+	if s.Proxy != nil {
+		t.Proxy = http.ProxyURL(s.Proxy)
+	}
+
+	if s.CABundleFile != "" || s.InsecureSkipVerify {
+		t.TLSClientConfig = &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: s.InsecureSkipVerify,
+		}
+	}
+
+	if s.CABundleFile != "" {
+		pem, err := os.ReadFile(s.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading CA bundle: %w", err)
+		}
+
+		// The bundle adds to the system certificates, so that a proxy
+		// re-signing some hosts only does not break the others.
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no PEM certificates found in %s", s.CABundleFile)
+		}
+		t.TLSClientConfig.RootCAs = pool
+	}
+
+	return t, nil
+}
+
+// resolveOVHEndpoint returns the URL of an OVH endpoint given by name, such
+// as ovh-eu, or as a URL, such as the one of a local test server.
+func resolveOVHEndpoint(endpoint string) (string, error) {
+	if !strings.Contains(endpoint, "/") {
+		if u, ok := ovh.Endpoints[endpoint]; ok {
+			return u, nil
+		}
+
+		names := make([]string, 0, len(ovh.Endpoints))
+		for name := range ovh.Endpoints {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return "", fmt.Errorf("unknown endpoint %q: use one of %s, or the URL of the API", endpoint, strings.Join(names, ", "))
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return "", fmt.Errorf("invalid endpoint %q: expected a name such as ovh-eu or an http or https URL such as https://eu.api.ovh.com/1.0", endpoint)
+	}
+	return strings.TrimRight(endpoint, "/"), nil
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveOVHEndpoint(t *testing.T) {
+	tests := map[string]struct {
+		endpoint string
+		want     string
+		wantErr  bool
+	}{
+		"name":           {endpoint: "ovh-ca", want: "https://ca.api.ovh.com/1.0"},
+		"url":            {endpoint: "https://eu.api.ovh.com/1.0", want: "https://eu.api.ovh.com/1.0"},
+		"trailing slash": {endpoint: "http://127.0.0.1:8080/", want: "http://127.0.0.1:8080"},
+		"unknown name":   {endpoint: "ovh-mars", wantErr: true},
+		"no scheme":      {endpoint: "eu.api.ovh.com/1.0", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := resolveOVHEndpoint(tt.endpoint)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTTPSettingsTransport(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, cert, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		settings httpSettings
+		wantErr  bool
+	}{
+		"untrusted":            {settings: httpSettings{}, wantErr: true},
+		"ca bundle":            {settings: httpSettings{CABundleFile: caFile}},
+		"insecure skip verify": {settings: httpSettings{InsecureSkipVerify: true}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			transport, err := tt.settings.transport()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := (httpSettings{CABundleFile: filepath.Join(t.TempDir(), "missing.pem")}).transport(); err == nil {
+		t.Errorf("expected an error for a missing CA bundle")
+	}
+}
@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ConfigValidator = jobTaskGraphValidator{}
+
+// jobTaskGraphValidator checks the dependency graph formed by the task blocks
+// of a job: task and job cluster keys are unique, depends_on and
+// job_cluster_key reference existing keys, and dependencies have no cycle.
+type jobTaskGraphValidator struct{}
+
+func (v jobTaskGraphValidator) Description(ctx context.Context) string {
+	return "task keys must be unique and task dependencies must reference existing tasks without forming a cycle"
+}
+
+func (v jobTaskGraphValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jobTaskGraphValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var tasks, clusters types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("task"), &tasks)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("job_cluster"), &clusters)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keys that are not known yet could match anything, so references are
+	// only checked once every key of the corresponding kind is known.
+	clusterKeys := map[string]bool{}
+	clusterKeysKnown := !clusters.IsUnknown()
+	for i, elem := range clusters.Elements() {
+		key, ok := objectString(elem, "job_cluster_key")
+		if !ok {
+			clusterKeysKnown = false
+			continue
+		}
+		if clusterKeys[key] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("job_cluster").AtListIndex(i).AtName("job_cluster_key"),
+				"Duplicate Job Cluster Key",
+				fmt.Sprintf("The job cluster key %q is used by more than one job_cluster block. Job cluster keys must be unique within a job.", key),
+			)
+		}
+		clusterKeys[key] = true
+	}
+
+	var keys []string
+	taskIndex := map[string]int{}
+	taskKeysKnown := !tasks.IsUnknown()
+	for i, elem := range tasks.Elements() {
+		key, ok := objectString(elem, "task_key")
+		if !ok {
+			taskKeysKnown = false
+			continue
+		}
+		if _, dup := taskIndex[key]; dup {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("task").AtListIndex(i).AtName("task_key"),
+				"Duplicate Task Key",
+				fmt.Sprintf("The task key %q is used by more than one task. Task keys must be unique within a job.", key),
+			)
+			continue
+		}
+		taskIndex[key] = i
+		keys = append(keys, key)
+	}
+
+	deps := map[string][]string{}
+	for i, elem := range tasks.Elements() {
+		key, _ := objectString(elem, "task_key")
+		taskPath := path.Root("task").AtListIndex(i)
+
+		if clusterKey, ok := objectString(elem, "job_cluster_key"); ok && clusterKeysKnown && !clusterKeys[clusterKey] {
+			resp.Diagnostics.AddAttributeError(
+				taskPath.AtName("job_cluster_key"),
+				"Unknown Job Cluster",
+				fmt.Sprintf("Task %q uses the job cluster %q, which is not the key of any job_cluster block of this job.", key, clusterKey),
+			)
+		}
+
+		dependsOn, ok := objectList(elem, "depends_on")
+		if !ok {
+			continue
+		}
+		for j, dep := range dependsOn.Elements() {
+			depKey, ok := objectString(dep, "task_key")
+			if !ok {
+				continue
+			}
+			if _, exists := taskIndex[depKey]; !exists {
+				if taskKeysKnown {
+					resp.Diagnostics.AddAttributeError(
+						taskPath.AtName("depends_on").AtListIndex(j).AtName("task_key"),
+						"Unknown Task Dependency",
+						fmt.Sprintf("Task %q depends on %q, which is not the key of any task of this job.", key, depKey),
+					)
+				}
+				continue
+			}
+			if taskIndex[key] == i {
+				deps[key] = append(deps[key], depKey)
+			}
+		}
+	}
+
+	if cycle := findTaskCycle(keys, deps); cycle != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("task").AtListIndex(taskIndex[cycle[0]]).AtName("depends_on"),
+			"Task Dependency Cycle",
+			fmt.Sprintf("The task dependencies form a cycle: %s. A task cannot depend, directly or indirectly, on itself.", strings.Join(cycle, " -> ")),
+		)
+	}
+}
+
+// findTaskCycle returns the keys along a dependency cycle, starting and
+// ending with the same key, or nil when the graph is acyclic. Keys are
+// visited in order so that the reported cycle is stable.
+func findTaskCycle(keys []string, deps map[string][]string) []string {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+
+	state := map[string]int{}
+	var stack []string
+
+	var visit func(key string) []string
+	visit = func(key string) []string {
+		state[key] = visiting
+		stack = append(stack, key)
+
+		for _, dep := range deps[key] {
+			switch state[dep] {
+			case visiting:
+				for i, k := range stack {
+					if k == dep {
+						return append(append([]string{}, stack[i:]...), dep)
+					}
+				}
+			case unvisited:
+				if cycle := visit(dep); cycle != nil {
+					return cycle
+				}
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[key] = done
+		return nil
+	}
+
+	for _, key := range keys {
+		if state[key] == unvisited {
+			if cycle := visit(key); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
+
+// objectString returns a known string attribute of an object value.
+func objectString(v attr.Value, name string) (string, bool) {
+	obj, ok := v.(types.Object)
+	if !ok || obj.IsNull() || obj.IsUnknown() {
+		return "", false
+	}
+
+	s, ok := obj.Attributes()[name].(types.String)
+	if !ok || s.IsNull() || s.IsUnknown() {
+		return "", false
+	}
+	return s.ValueString(), true
+}
+
+// objectList returns a known list attribute of an object value.
+func objectList(v attr.Value, name string) (types.List, bool) {
+	obj, ok := v.(types.Object)
+	if !ok || obj.IsNull() || obj.IsUnknown() {
+		return types.List{}, false
+	}
+
+	l, ok := obj.Attributes()[name].(types.List)
+	if !ok || l.IsNull() || l.IsUnknown() {
+		return types.List{}, false
+	}
+	return l, true
+}
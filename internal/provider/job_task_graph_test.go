@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type graphTestTask struct {
+	TaskKey       types.String          `tfsdk:"task_key"`
+	JobClusterKey types.String          `tfsdk:"job_cluster_key"`
+	DependsOn     []TaskDependencyModel `tfsdk:"depends_on"`
+}
+
+type graphTestCluster struct {
+	JobClusterKey types.String `tfsdk:"job_cluster_key"`
+}
+
+func graphTestConfig(t *testing.T, tasks []graphTestTask, clusters []graphTestCluster) tfsdk.Config {
+	t.Helper()
+	ctx := context.Background()
+
+	s := schema.Schema{
+		Blocks: map[string]schema.Block{
+			"task": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"task_key":        schema.StringAttribute{Required: true},
+						"job_cluster_key": schema.StringAttribute{Optional: true},
+					},
+					Blocks: map[string]schema.Block{
+						"depends_on": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"task_key": schema.StringAttribute{Required: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			"job_cluster": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"job_cluster_key": schema.StringAttribute{Required: true},
+					},
+				},
+			},
+		},
+	}
+
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := state.SetAttribute(ctx, path.Root("task"), tasks); diags.HasError() {
+		t.Fatalf("setting tasks: %v", diags)
+	}
+	if diags := state.SetAttribute(ctx, path.Root("job_cluster"), clusters); diags.HasError() {
+		t.Fatalf("setting job clusters: %v", diags)
+	}
+	return tfsdk.Config{Schema: s, Raw: state.Raw}
+}
+
+func newGraphTestTask(key string, deps ...string) graphTestTask {
+	t := graphTestTask{
+		TaskKey:       types.StringValue(key),
+		JobClusterKey: types.StringNull(),
+		DependsOn:     []TaskDependencyModel{},
+	}
+	for _, dep := range deps {
+		t.DependsOn = append(t.DependsOn, TaskDependencyModel{TaskKey: types.StringValue(dep)})
+	}
+	return t
+}
+
+func TestJobTaskGraphValidator(t *testing.T) {
+	onCluster := newGraphTestTask("load")
+	onCluster.JobClusterKey = types.StringValue("missing")
+
+	tests := map[string]struct {
+		tasks    []graphTestTask
+		clusters []graphTestCluster
+		summary  string
+		detail   string
+	}{
+		"valid": {
+			tasks: []graphTestTask{newGraphTestTask("extract"), newGraphTestTask("transform", "extract"), newGraphTestTask("load", "extract", "transform")},
+		},
+		"duplicate key": {
+			tasks:   []graphTestTask{newGraphTestTask("extract"), newGraphTestTask("extract")},
+			summary: "Duplicate Task Key",
+		},
+		"dangling dependency": {
+			tasks:   []graphTestTask{newGraphTestTask("extract"), newGraphTestTask("load", "transform")},
+			summary: "Unknown Task Dependency",
+		},
+		"self dependency": {
+			tasks:   []graphTestTask{newGraphTestTask("extract", "extract")},
+			summary: "Task Dependency Cycle",
+			detail:  "extract -> extract",
+		},
+		"cycle": {
+			tasks:   []graphTestTask{newGraphTestTask("extract", "load"), newGraphTestTask("transform", "extract"), newGraphTestTask("load", "transform")},
+			summary: "Task Dependency Cycle",
+			detail:  "extract -> load -> transform -> extract",
+		},
+		"unknown job cluster": {
+			tasks:    []graphTestTask{onCluster},
+			clusters: []graphTestCluster{{JobClusterKey: types.StringValue("main")}},
+			summary:  "Unknown Job Cluster",
+		},
+		"duplicate job cluster": {
+			clusters: []graphTestCluster{{JobClusterKey: types.StringValue("main")}, {JobClusterKey: types.StringValue("main")}},
+			summary:  "Duplicate Job Cluster Key",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{Config: graphTestConfig(t, tt.tasks, tt.clusters)}
+			var resp resource.ValidateConfigResponse
+			jobTaskGraphValidator{}.ValidateResource(context.Background(), req, &resp)
+
+			if tt.summary == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.ErrorsCount() != 1 {
+				t.Fatalf("expected one error, got %v", resp.Diagnostics)
+			}
+			d := resp.Diagnostics.Errors()[0]
+			if d.Summary() != tt.summary {
+				t.Errorf("got summary %q, want %q", d.Summary(), tt.summary)
+			}
+			if !strings.Contains(d.Detail(), tt.detail) {
+				t.Errorf("detail %q does not contain %q", d.Detail(), tt.detail)
+			}
+		})
+	}
+}
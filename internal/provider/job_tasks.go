@@ -0,0 +1,538 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+)
+
+// jobTaskTypes lists the blocks that define what a job runs. At most one of
+// them may be set.
+var jobTaskTypes = []string{
+	"notebook_task",
+	"spark_jar_task",
+	"spark_python_task",
+	"spark_submit_task",
+	"pipeline_task",
+	"python_wheel_task",
+}
+
+// NotebookTaskModel describes a notebook_task block.
+type NotebookTaskModel struct {
+	NotebookPath   types.String `tfsdk:"notebook_path"`
+	BaseParameters types.Map    `tfsdk:"base_parameters"`
+}
+
+// SparkJarTaskModel describes a spark_jar_task block.
+type SparkJarTaskModel struct {
+	MainClassName types.String `tfsdk:"main_class_name"`
+	Parameters    types.List   `tfsdk:"parameters"`
+}
+
+// SparkPythonTaskModel describes a spark_python_task block.
+type SparkPythonTaskModel struct {
+	PythonFile types.String `tfsdk:"python_file"`
+	Parameters types.List   `tfsdk:"parameters"`
+}
+
+// SparkSubmitTaskModel describes a spark_submit_task block.
+type SparkSubmitTaskModel struct {
+	Parameters types.List `tfsdk:"parameters"`
+}
+
+// PipelineTaskModel describes a pipeline_task block.
+type PipelineTaskModel struct {
+	PipelineID types.String `tfsdk:"pipeline_id"`
+}
+
+// PythonWheelTaskModel describes a python_wheel_task block.
+type PythonWheelTaskModel struct {
+	PackageName types.String `tfsdk:"package_name"`
+	EntryPoint  types.String `tfsdk:"entry_point"`
+	Parameters  types.List   `tfsdk:"parameters"`
+}
+
+// jobTaskBlocks returns the schema of the task type blocks.
+func jobTaskBlocks() map[string]schema.Block {
+	return map[string]schema.Block{
+		"notebook_task": singleBlock("Notebook task", map[string]schema.Attribute{
+			"notebook_path": schema.StringAttribute{
+				Description: "Notebook path",
+				Required:    true,
+			},
+			"base_parameters": schema.MapAttribute{
+				Description: "Base parameters",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		}),
+		"spark_jar_task": singleBlock("Spark JAR task", map[string]schema.Attribute{
+			"main_class_name": schema.StringAttribute{
+				Description: "Main class name",
+				Required:    true,
+			},
+			"parameters": schema.ListAttribute{
+				Description: "Parameters",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		}),
+		"spark_python_task": singleBlock("Spark Python task", map[string]schema.Attribute{
+			"python_file": schema.StringAttribute{
+				Description: "Python file path",
+				Required:    true,
+			},
+			"parameters": schema.ListAttribute{
+				Description: "Parameters",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		}),
+		"spark_submit_task": singleBlock("Spark submit task", map[string]schema.Attribute{
+			"parameters": schema.ListAttribute{
+				Description: "Parameters",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		}),
+		"pipeline_task": singleBlock("Pipeline task", map[string]schema.Attribute{
+			"pipeline_id": schema.StringAttribute{
+				Description: "Pipeline ID",
+				Required:    true,
+			},
+		}),
+		"python_wheel_task": singleBlock("Python wheel task", map[string]schema.Attribute{
+			"package_name": schema.StringAttribute{
+				Description: "Package name",
+				Required:    true,
+			},
+			"entry_point": schema.StringAttribute{
+				Description: "Entry point",
+				Required:    true,
+			},
+			"parameters": schema.ListAttribute{
+				Description: "Parameters",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		}),
+	}
+}
+
+// singleBlock returns a list block that holds at most one element, the
+// framework equivalent of an SDKv2 TypeList with MaxItems 1.
+func singleBlock(description string, attributes map[string]schema.Attribute) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: description,
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: attributes,
+		},
+	}
+}
+
+func expandNotebookTask(ctx context.Context, tasks []NotebookTaskModel, diags *diag.Diagnostics) *client.NotebookTask {
+	if len(tasks) == 0 {
+		return nil
+	}
+	return &client.NotebookTask{
+		NotebookPath:   tasks[0].NotebookPath.ValueString(),
+		BaseParameters: mapToStrings(ctx, tasks[0].BaseParameters, diags),
+	}
+}
+
+func flattenNotebookTask(ctx context.Context, task *client.NotebookTask, diags *diag.Diagnostics) []NotebookTaskModel {
+	if task == nil {
+		return []NotebookTaskModel{}
+	}
+	return []NotebookTaskModel{{
+		NotebookPath:   types.StringValue(task.NotebookPath),
+		BaseParameters: stringsToMap(ctx, task.BaseParameters, diags),
+	}}
+}
+
+func expandSparkJarTask(ctx context.Context, tasks []SparkJarTaskModel, diags *diag.Diagnostics) *client.SparkJarTask {
+	if len(tasks) == 0 {
+		return nil
+	}
+	return &client.SparkJarTask{
+		MainClassName: tasks[0].MainClassName.ValueString(),
+		Parameters:    listToStrings(ctx, tasks[0].Parameters, diags),
+	}
+}
+
+func flattenSparkJarTask(ctx context.Context, task *client.SparkJarTask, diags *diag.Diagnostics) []SparkJarTaskModel {
+	if task == nil {
+		return []SparkJarTaskModel{}
+	}
+	return []SparkJarTaskModel{{
+		MainClassName: types.StringValue(task.MainClassName),
+		Parameters:    stringsToList(ctx, task.Parameters, diags),
+	}}
+}
+
+func expandSparkPythonTask(ctx context.Context, tasks []SparkPythonTaskModel, diags *diag.Diagnostics) *client.SparkPythonTask {
+	if len(tasks) == 0 {
+		return nil
+	}
+	return &client.SparkPythonTask{
+		PythonFile: tasks[0].PythonFile.ValueString(),
+		Parameters: listToStrings(ctx, tasks[0].Parameters, diags),
+	}
+}
+
+func flattenSparkPythonTask(ctx context.Context, task *client.SparkPythonTask, diags *diag.Diagnostics) []SparkPythonTaskModel {
+	if task == nil {
+		return []SparkPythonTaskModel{}
+	}
+	return []SparkPythonTaskModel{{
+		PythonFile: types.StringValue(task.PythonFile),
+		Parameters: stringsToList(ctx, task.Parameters, diags),
+	}}
+}
+
+func expandSparkSubmitTask(ctx context.Context, tasks []SparkSubmitTaskModel, diags *diag.Diagnostics) *client.SparkSubmitTask {
+	if len(tasks) == 0 {
+		return nil
+	}
+	return &client.SparkSubmitTask{
+		Parameters: listToStrings(ctx, tasks[0].Parameters, diags),
+	}
+}
+
+func flattenSparkSubmitTask(ctx context.Context, task *client.SparkSubmitTask, diags *diag.Diagnostics) []SparkSubmitTaskModel {
+	if task == nil {
+		return []SparkSubmitTaskModel{}
+	}
+	return []SparkSubmitTaskModel{{
+		Parameters: stringsToList(ctx, task.Parameters, diags),
+	}}
+}
+
+func expandPipelineTask(tasks []PipelineTaskModel) *client.PipelineTask {
+	if len(tasks) == 0 {
+		return nil
+	}
+	return &client.PipelineTask{
+		PipelineID: tasks[0].PipelineID.ValueString(),
+	}
+}
+
+func flattenPipelineTask(task *client.PipelineTask) []PipelineTaskModel {
+	if task == nil {
+		return []PipelineTaskModel{}
+	}
+	return []PipelineTaskModel{{
+		PipelineID: types.StringValue(task.PipelineID),
+	}}
+}
+
+func expandPythonWheelTask(ctx context.Context, tasks []PythonWheelTaskModel, diags *diag.Diagnostics) *client.PythonWheelTask {
+	if len(tasks) == 0 {
+		return nil
+	}
+	return &client.PythonWheelTask{
+		PackageName: tasks[0].PackageName.ValueString(),
+		EntryPoint:  tasks[0].EntryPoint.ValueString(),
+		Parameters:  listToStrings(ctx, tasks[0].Parameters, diags),
+	}
+}
+
+func flattenPythonWheelTask(ctx context.Context, task *client.PythonWheelTask, diags *diag.Diagnostics) []PythonWheelTaskModel {
+	if task == nil {
+		return []PythonWheelTaskModel{}
+	}
+	return []PythonWheelTaskModel{{
+		PackageName: types.StringValue(task.PackageName),
+		EntryPoint:  types.StringValue(task.EntryPoint),
+		Parameters:  stringsToList(ctx, task.Parameters, diags),
+	}}
+}
+
+// JobTaskModel describes a task block of a multi-task job.
+type JobTaskModel struct {
+	TaskKey                types.String           `tfsdk:"task_key"`
+	Description            types.String           `tfsdk:"description"`
+	DependsOn              []TaskDependencyModel  `tfsdk:"depends_on"`
+	RunIf                  types.String           `tfsdk:"run_if"`
+	JobClusterKey          types.String           `tfsdk:"job_cluster_key"`
+	ExistingClusterID      types.String           `tfsdk:"existing_cluster_id"`
+	NewCluster             []ClusterSpecModel     `tfsdk:"new_cluster"`
+	NotebookTask           []NotebookTaskModel    `tfsdk:"notebook_task"`
+	SparkJarTask           []SparkJarTaskModel    `tfsdk:"spark_jar_task"`
+	SparkPythonTask        []SparkPythonTaskModel `tfsdk:"spark_python_task"`
+	SparkSubmitTask        []SparkSubmitTaskModel `tfsdk:"spark_submit_task"`
+	PipelineTask           []PipelineTaskModel    `tfsdk:"pipeline_task"`
+	PythonWheelTask        []PythonWheelTaskModel `tfsdk:"python_wheel_task"`
+	Libraries              []LibraryModel         `tfsdk:"libraries"`
+	TimeoutSeconds         types.Int64            `tfsdk:"timeout_seconds"`
+	MaxRetries             types.Int64            `tfsdk:"max_retries"`
+	MinRetryIntervalMillis types.Int64            `tfsdk:"min_retry_interval_millis"`
+	RetryOnTimeout         types.Bool             `tfsdk:"retry_on_timeout"`
+}
+
+// TaskDependencyModel describes a depends_on block.
+type TaskDependencyModel struct {
+	TaskKey types.String `tfsdk:"task_key"`
+}
+
+// JobClusterModel describes a job_cluster block.
+type JobClusterModel struct {
+	JobClusterKey types.String       `tfsdk:"job_cluster_key"`
+	NewCluster    []ClusterSpecModel `tfsdk:"new_cluster"`
+}
+
+// jobTaskBlock returns the schema of the task block of a multi-task job.
+func jobTaskBlock() schema.ListNestedBlock {
+	blocks := jobTaskBlocks()
+	blocks["new_cluster"] = clusterSpecBlock()
+	blocks["libraries"] = librariesBlock()
+	blocks["depends_on"] = schema.ListNestedBlock{
+		Description: "Tasks that must complete before this task runs",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"task_key": schema.StringAttribute{
+					Description: "Key of the task this task depends on",
+					Required:    true,
+				},
+			},
+		},
+	}
+
+	return schema.ListNestedBlock{
+		Description: "Tasks of a multi-task job",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"task_key": schema.StringAttribute{
+					Description: "Unique key of the task within the job",
+					Required:    true,
+				},
+				"description": schema.StringAttribute{
+					Description: "Task description",
+					Optional:    true,
+				},
+				"run_if": schema.StringAttribute{
+					Description: "Condition on the outcome of the depends_on tasks for this task to run",
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString(client.RunIfAllSuccess),
+					Validators: []validator.String{
+						stringvalidator.OneOf(
+							client.RunIfAllSuccess,
+							client.RunIfAtLeastOneSuccess,
+							client.RunIfNoneFailed,
+							client.RunIfAllDone,
+							client.RunIfAtLeastOneFailed,
+							client.RunIfAllFailed,
+						),
+					},
+				},
+				"job_cluster_key": schema.StringAttribute{
+					Description: "Key of the job_cluster the task runs on",
+					Optional:    true,
+				},
+				"existing_cluster_id": schema.StringAttribute{
+					Description: "Existing cluster ID",
+					Optional:    true,
+				},
+				"timeout_seconds": schema.Int64Attribute{
+					Description: "Timeout in seconds",
+					Optional:    true,
+				},
+				"max_retries": schema.Int64Attribute{
+					Description: "Maximum retries",
+					Optional:    true,
+				},
+				"min_retry_interval_millis": schema.Int64Attribute{
+					Description: "Minimum retry interval in milliseconds",
+					Optional:    true,
+				},
+				"retry_on_timeout": schema.BoolAttribute{
+					Description: "Retry on timeout",
+					Optional:    true,
+				},
+			},
+			Blocks: blocks,
+		},
+	}
+}
+
+// jobClusterBlock returns the schema of the job_cluster block.
+func jobClusterBlock() schema.ListNestedBlock {
+	newCluster := clusterSpecBlock()
+	newCluster.Validators = []validator.List{
+		listvalidator.IsRequired(),
+		listvalidator.SizeAtMost(1),
+	}
+
+	return schema.ListNestedBlock{
+		Description: "Cluster specifications shared by the tasks of the job",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"job_cluster_key": schema.StringAttribute{
+					Description: "Unique key of the cluster specification within the job",
+					Required:    true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"new_cluster": newCluster,
+			},
+		},
+	}
+}
+
+func expandJobTasks(ctx context.Context, tasks []JobTaskModel, diags *diag.Diagnostics) []client.JobTask {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	out := make([]client.JobTask, 0, len(tasks))
+	for _, task := range tasks {
+		var dependsOn []client.TaskDependency
+		for _, dep := range task.DependsOn {
+			dependsOn = append(dependsOn, client.TaskDependency{TaskKey: dep.TaskKey.ValueString()})
+		}
+
+		out = append(out, client.JobTask{
+			TaskKey:                task.TaskKey.ValueString(),
+			Description:            task.Description.ValueString(),
+			DependsOn:              dependsOn,
+			RunIf:                  task.RunIf.ValueString(),
+			JobClusterKey:          task.JobClusterKey.ValueString(),
+			ExistingClusterID:      task.ExistingClusterID.ValueString(),
+			NewCluster:             expandClusterSpec(task.NewCluster),
+			NotebookTask:           expandNotebookTask(ctx, task.NotebookTask, diags),
+			SparkJarTask:           expandSparkJarTask(ctx, task.SparkJarTask, diags),
+			SparkPythonTask:        expandSparkPythonTask(ctx, task.SparkPythonTask, diags),
+			SparkSubmitTask:        expandSparkSubmitTask(ctx, task.SparkSubmitTask, diags),
+			PipelineTask:           expandPipelineTask(task.PipelineTask),
+			PythonWheelTask:        expandPythonWheelTask(ctx, task.PythonWheelTask, diags),
+			Libraries:              expandLibraries(ctx, task.Libraries, diags),
+			TimeoutSeconds:         task.TimeoutSeconds.ValueInt64Pointer(),
+			MaxRetries:             task.MaxRetries.ValueInt64Pointer(),
+			MinRetryIntervalMillis: task.MinRetryIntervalMillis.ValueInt64Pointer(),
+			RetryOnTimeout:         task.RetryOnTimeout.ValueBoolPointer(),
+		})
+	}
+	return out
+}
+
+func flattenJobTasks(ctx context.Context, tasks []client.JobTask, diags *diag.Diagnostics) []JobTaskModel {
+	out := make([]JobTaskModel, 0, len(tasks))
+	for _, task := range tasks {
+		dependsOn := make([]TaskDependencyModel, 0, len(task.DependsOn))
+		for _, dep := range task.DependsOn {
+			dependsOn = append(dependsOn, TaskDependencyModel{TaskKey: types.StringValue(dep.TaskKey)})
+		}
+
+		runIf := task.RunIf
+		if runIf == "" {
+			runIf = client.RunIfAllSuccess
+		}
+
+		out = append(out, JobTaskModel{
+			TaskKey:                types.StringValue(task.TaskKey),
+			Description:            stringValueOrNull(task.Description),
+			DependsOn:              dependsOn,
+			RunIf:                  types.StringValue(runIf),
+			JobClusterKey:          stringValueOrNull(task.JobClusterKey),
+			ExistingClusterID:      stringValueOrNull(task.ExistingClusterID),
+			NewCluster:             flattenClusterSpec(task.NewCluster),
+			NotebookTask:           flattenNotebookTask(ctx, task.NotebookTask, diags),
+			SparkJarTask:           flattenSparkJarTask(ctx, task.SparkJarTask, diags),
+			SparkPythonTask:        flattenSparkPythonTask(ctx, task.SparkPythonTask, diags),
+			SparkSubmitTask:        flattenSparkSubmitTask(ctx, task.SparkSubmitTask, diags),
+			PipelineTask:           flattenPipelineTask(task.PipelineTask),
+			PythonWheelTask:        flattenPythonWheelTask(ctx, task.PythonWheelTask, diags),
+			Libraries:              flattenLibraries(ctx, task.Libraries, diags),
+			TimeoutSeconds:         types.Int64PointerValue(task.TimeoutSeconds),
+			MaxRetries:             types.Int64PointerValue(task.MaxRetries),
+			MinRetryIntervalMillis: types.Int64PointerValue(task.MinRetryIntervalMillis),
+			RetryOnTimeout:         types.BoolPointerValue(task.RetryOnTimeout),
+		})
+	}
+	return out
+}
+
+func expandJobClusters(clusters []JobClusterModel) []client.JobCluster {
+	if len(clusters) == 0 {
+		return nil
+	}
+
+	out := make([]client.JobCluster, 0, len(clusters))
+	for _, cluster := range clusters {
+		jc := client.JobCluster{JobClusterKey: cluster.JobClusterKey.ValueString()}
+		if spec := expandClusterSpec(cluster.NewCluster); spec != nil {
+			jc.NewCluster = *spec
+		}
+		out = append(out, jc)
+	}
+	return out
+}
+
+func flattenJobClusters(clusters []client.JobCluster) []JobClusterModel {
+	out := make([]JobClusterModel, 0, len(clusters))
+	for _, cluster := range clusters {
+		out = append(out, JobClusterModel{
+			JobClusterKey: types.StringValue(cluster.JobClusterKey),
+			NewCluster:    flattenClusterSpec(&cluster.NewCluster),
+		})
+	}
+	return out
+}
+
+// validateJobTaskSettings checks that the job or task at base sets at most
+// one task type and at most one way of providing its cluster.
+func validateJobTaskSettings(ctx context.Context, config tfsdk.Config, base path.Path, clusterAttributes []string, diags *diag.Diagnostics) {
+	taskTypes := configuredBlocks(ctx, config, base, jobTaskTypes, diags)
+	if len(taskTypes) > 1 {
+		diags.AddAttributeError(
+			base.AtName(taskTypes[1]),
+			"Conflicting Job Task Types",
+			fmt.Sprintf("A job or task runs a single task type, but %s are all set.", strings.Join(taskTypes, ", ")),
+		)
+	}
+
+	clusters := configuredBlocks(ctx, config, base, []string{"new_cluster"}, diags)
+	for _, name := range clusterAttributes {
+		var v types.String
+		diags.Append(config.GetAttribute(ctx, base.AtName(name), &v)...)
+		if !v.IsNull() {
+			clusters = append(clusters, name)
+		}
+	}
+	if len(clusters) > 1 {
+		diags.AddAttributeError(
+			base.AtName(clusters[1]),
+			"Conflicting Job Cluster Configuration",
+			fmt.Sprintf("Only one of new_cluster, %s can be set, but %s are all set.", strings.Join(clusterAttributes, ", "), strings.Join(clusters, ", ")),
+		)
+	}
+}
+
+// configuredBlocks returns the names of the list blocks under base that have
+// at least one element in the configuration.
+func configuredBlocks(ctx context.Context, config tfsdk.Config, base path.Path, names []string, diags *diag.Diagnostics) []string {
+	var set []string
+	for _, name := range names {
+		var block types.List
+		diags.Append(config.GetAttribute(ctx, base.AtName(name), &block)...)
+		if len(block.Elements()) > 0 {
+			set = append(set, name)
+		}
+	}
+	return set
+}
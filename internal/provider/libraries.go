@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+)
+
+// LibraryModel describes a libraries block.
+type LibraryModel struct {
+	Jar   types.String        `tfsdk:"jar"`
+	Egg   types.String        `tfsdk:"egg"`
+	Whl   types.String        `tfsdk:"whl"`
+	PyPI  []PyPILibraryModel  `tfsdk:"pypi"`
+	Maven []MavenLibraryModel `tfsdk:"maven"`
+	CRAN  []CRANLibraryModel  `tfsdk:"cran"`
+}
+
+// PyPILibraryModel describes a pypi block.
+type PyPILibraryModel struct {
+	Package types.String `tfsdk:"package"`
+	Repo    types.String `tfsdk:"repo"`
+}
+
+// MavenLibraryModel describes a maven block.
+type MavenLibraryModel struct {
+	Coordinates types.String `tfsdk:"coordinates"`
+	Repo        types.String `tfsdk:"repo"`
+	Exclusions  types.List   `tfsdk:"exclusions"`
+}
+
+// CRANLibraryModel describes a cran block.
+type CRANLibraryModel struct {
+	Package types.String `tfsdk:"package"`
+	Repo    types.String `tfsdk:"repo"`
+}
+
+func librariesBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: "Libraries",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"jar": schema.StringAttribute{
+					Description: "JAR library",
+					Optional:    true,
+				},
+				"egg": schema.StringAttribute{
+					Description: "Egg library",
+					Optional:    true,
+				},
+				"whl": schema.StringAttribute{
+					Description: "Wheel library",
+					Optional:    true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"pypi": schema.ListNestedBlock{
+					Description: "PyPI library",
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"package": schema.StringAttribute{
+								Description: "Package name",
+								Required:    true,
+							},
+							"repo": schema.StringAttribute{
+								Description: "Repository",
+								Optional:    true,
+							},
+						},
+					},
+				},
+				"maven": schema.ListNestedBlock{
+					Description: "Maven library",
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"coordinates": schema.StringAttribute{
+								Description: "Maven coordinates",
+								Required:    true,
+							},
+							"repo": schema.StringAttribute{
+								Description: "Repository",
+								Optional:    true,
+							},
+							"exclusions": schema.ListAttribute{
+								Description: "Exclusions",
+								Optional:    true,
+								ElementType: types.StringType,
+							},
+						},
+					},
+				},
+				"cran": schema.ListNestedBlock{
+					Description: "CRAN library",
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"package": schema.StringAttribute{
+								Description: "Package name",
+								Required:    true,
+							},
+							"repo": schema.StringAttribute{
+								Description: "Repository",
+								Optional:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandLibraries(ctx context.Context, libraries []LibraryModel, diags *diag.Diagnostics) []client.Library {
+	var out []client.Library
+	for _, library := range libraries {
+		lib := client.Library{
+			Jar: library.Jar.ValueString(),
+			Egg: library.Egg.ValueString(),
+			Whl: library.Whl.ValueString(),
+		}
+		if len(library.PyPI) > 0 {
+			lib.PyPI = &client.PythonPyPI{
+				Package: library.PyPI[0].Package.ValueString(),
+				Repo:    library.PyPI[0].Repo.ValueString(),
+			}
+		}
+		if len(library.Maven) > 0 {
+			lib.Maven = &client.MavenLibrary{
+				Coordinates: library.Maven[0].Coordinates.ValueString(),
+				Repo:        library.Maven[0].Repo.ValueString(),
+				Exclusions:  listToStrings(ctx, library.Maven[0].Exclusions, diags),
+			}
+		}
+		if len(library.CRAN) > 0 {
+			lib.CRAN = &client.RCRAN{
+				Package: library.CRAN[0].Package.ValueString(),
+				Repo:    library.CRAN[0].Repo.ValueString(),
+			}
+		}
+		out = append(out, lib)
+	}
+	return out
+}
+
+func flattenLibraries(ctx context.Context, libraries []client.Library, diags *diag.Diagnostics) []LibraryModel {
+	out := make([]LibraryModel, 0, len(libraries))
+	for _, library := range libraries {
+		lib := LibraryModel{
+			Jar:   stringValueOrNull(library.Jar),
+			Egg:   stringValueOrNull(library.Egg),
+			Whl:   stringValueOrNull(library.Whl),
+			PyPI:  []PyPILibraryModel{},
+			Maven: []MavenLibraryModel{},
+			CRAN:  []CRANLibraryModel{},
+		}
+		if library.PyPI != nil {
+			lib.PyPI = append(lib.PyPI, PyPILibraryModel{
+				Package: types.StringValue(library.PyPI.Package),
+				Repo:    stringValueOrNull(library.PyPI.Repo),
+			})
+		}
+		if library.Maven != nil {
+			lib.Maven = append(lib.Maven, MavenLibraryModel{
+				Coordinates: types.StringValue(library.Maven.Coordinates),
+				Repo:        stringValueOrNull(library.Maven.Repo),
+				Exclusions:  stringsToList(ctx, library.Maven.Exclusions, diags),
+			})
+		}
+		if library.CRAN != nil {
+			lib.CRAN = append(lib.CRAN, CRANLibraryModel{
+				Package: types.StringValue(library.CRAN.Package),
+				Repo:    stringValueOrNull(library.CRAN.Repo),
+			})
+		}
+		out = append(out, lib)
+	}
+	return out
+}
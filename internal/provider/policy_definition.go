@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = policyDefinitionType{}
+	_ basetypes.StringValuableWithSemanticEquals = policyDefinition{}
+	_ xattr.ValidateableAttribute                = policyDefinition{}
+)
+
+// policyDefinitionType is the type of cluster policy definitions. Like
+// jsontypes.NormalizedType, definitions that only differ in formatting or key
+// order are equal, so the formatting of the configuration is kept; invalid
+// JSON is reported with the key where parsing stopped.
+type policyDefinitionType struct {
+	jsontypes.NormalizedType
+}
+
+func (t policyDefinitionType) String() string {
+	return "provider.policyDefinitionType"
+}
+
+func (t policyDefinitionType) ValueType(ctx context.Context) attr.Value {
+	return policyDefinition{}
+}
+
+func (t policyDefinitionType) Equal(o attr.Type) bool {
+	other, ok := o.(policyDefinitionType)
+	return ok && t.NormalizedType.Equal(other.NormalizedType)
+}
+
+func (t policyDefinitionType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return policyDefinition{Normalized: jsontypes.Normalized{StringValue: in}}, nil
+}
+
+func (t policyDefinitionType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	v, err := t.NormalizedType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return policyDefinition{Normalized: v.(jsontypes.Normalized)}, nil
+}
+
+// policyDefinition is a cluster policy definition, a JSON object mapping
+// cluster attribute paths to policy rules.
+type policyDefinition struct {
+	jsontypes.Normalized
+}
+
+func newPolicyDefinitionValue(s string) policyDefinition {
+	return policyDefinition{Normalized: jsontypes.NewNormalizedValue(s)}
+}
+
+func newPolicyDefinitionNull() policyDefinition {
+	return policyDefinition{Normalized: jsontypes.NewNormalizedNull()}
+}
+
+func (v policyDefinition) Type(ctx context.Context) attr.Type {
+	return policyDefinitionType{}
+}
+
+func (v policyDefinition) Equal(o attr.Value) bool {
+	other, ok := o.(policyDefinition)
+	return ok && v.Normalized.Equal(other.Normalized)
+}
+
+func (v policyDefinition) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	newValue, ok := newValuable.(policyDefinition)
+	if !ok {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected a policy definition, got: %T. Please report this issue to the provider developers.", newValuable),
+		)
+		return false, diags
+	}
+	return v.Normalized.StringSemanticEquals(ctx, newValue.Normalized)
+}
+
+func (v policyDefinition) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if err := checkJSON(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Policy Definition",
+			fmt.Sprintf("The policy definition is not valid JSON: %s.", err),
+		)
+	}
+}
+
+// checkJSON reports where a JSON document stops being valid: the line and
+// column, and the key being parsed, written as in HCL such as
+// ["spark_version"]["value"].
+func checkJSON(s string) error {
+	dec := json.NewDecoder(strings.NewReader(s))
+
+	type frame struct {
+		object bool
+		key    string
+		index  int
+	}
+	var stack []*frame
+	// expectKey is set when the next string of an object is a key.
+	expectKey := false
+
+	where := func(offset int64) string {
+		var p strings.Builder
+		for _, f := range stack {
+			switch {
+			case f.object && f.key != "":
+				p.WriteString("[" + strconv.Quote(f.key) + "]")
+			case !f.object:
+				p.WriteString("[" + strconv.Itoa(f.index) + "]")
+			}
+		}
+
+		line := 1 + bytes.Count([]byte(s[:offset]), []byte("\n"))
+		column := offset - int64(strings.LastIndex(s[:offset], "\n"))
+		if p.Len() == 0 {
+			return fmt.Sprintf("line %d, column %d", line, column)
+		}
+		return fmt.Sprintf("line %d, column %d, near %s", line, column, p.String())
+	}
+
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			// The document ended before its value, or before the value closed.
+			return fmt.Errorf("unexpected end of JSON input at %s", where(int64(len(s))))
+		}
+		if err != nil {
+			offset := dec.InputOffset()
+			var syntaxErr *json.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				// The offset is past the invalid character.
+				offset = syntaxErr.Offset - 1
+			}
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				offset = int64(len(s))
+			}
+			offset = min(max(offset, 0), int64(len(s)))
+			return fmt.Errorf("%s at %s", err, where(offset))
+		}
+
+		if key, ok := tok.(string); ok && expectKey {
+			stack[len(stack)-1].key = key
+			expectKey = false
+			continue
+		}
+
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			stack = append(stack, &frame{object: tok == json.Delim('{')})
+			expectKey = tok == json.Delim('{')
+			continue
+		case json.Delim('}'), json.Delim(']'):
+			stack = stack[:len(stack)-1]
+		}
+
+		// A value ended: move to the next key or element of its parent.
+		if len(stack) == 0 {
+			// The document is a single value: anything but white space after
+			// it is invalid.
+			rest := s[dec.InputOffset():]
+			if trimmed := strings.TrimLeft(rest, " \t\r\n"); trimmed != "" {
+				offset := int64(len(s) - len(trimmed))
+				return fmt.Errorf("invalid character %q after top-level value at %s", trimmed[0], where(offset))
+			}
+			return nil
+		}
+		parent := stack[len(stack)-1]
+		if parent.object {
+			expectKey = true
+		} else {
+			parent.index++
+		}
+	}
+}
+
+// policyDefinitionValidator checks that a policy definition maps each
+// cluster attribute path to a rule object with a type.
+type policyDefinitionValidator struct{}
+
+func (v policyDefinitionValidator) Description(ctx context.Context) string {
+	return "value must be a JSON object mapping cluster attribute paths to policy rules"
+}
+
+func (v policyDefinitionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v policyDefinitionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// Malformed JSON is reported, with where it stops being valid, by the
+	// policyDefinition type itself.
+	value := []byte(req.ConfigValue.ValueString())
+	if !json.Valid(value) {
+		return
+	}
+
+	var rules map[string]json.RawMessage
+	if err := json.Unmarshal(value, &rules); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Policy Definition",
+			"The policy definition must be a JSON object mapping cluster attribute paths, such as \"spark_version\", to policy rules.",
+		)
+		return
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(rules)) {
+		var rule struct {
+			Type *string `json:"type"`
+		}
+		if err := json.Unmarshal(rules[key], &rule); err != nil || rule.Type == nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Policy Rule",
+				fmt.Sprintf("The rule of [%q] must be a JSON object with a \"type\", such as {\"type\": \"fixed\", \"value\": \"14.3.x-scala2.12\"}.", key),
+			)
+		}
+	}
+}
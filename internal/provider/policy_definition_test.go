@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheckJSON(t *testing.T) {
+	tests := map[string]struct {
+		json string
+		want string
+	}{
+		"valid": {
+			json: `{"spark_version": {"type": "fixed", "value": "14.3.x-scala2.12"}}`,
+		},
+		"missing value": {
+			json: "{\n  \"spark_version\": {\"type\": \"fixed\", \"value\": }\n}",
+			want: "missing value after object key at line 2, column 47, near [\"spark_version\"][\"value\"]",
+		},
+		"trailing comma": {
+			json: `{"node_type_id": {"type": "allowlist", "values": ["b3-8", "b3-16",]}}`,
+			want: "invalid character ',' looking for beginning of value at line 1, column 66, near [\"node_type_id\"][\"values\"][2]",
+		},
+		"unterminated": {
+			json: `{"autotermination_minutes": {"type": "range"`,
+			want: "unexpected end of JSON input at line 1, column 45, near [\"autotermination_minutes\"][\"type\"]",
+		},
+		"second object": {
+			json: `{"a": {"type": "fixed"}} {"b": 1}`,
+			want: "invalid character '{' after top-level value at line 1, column 26",
+		},
+		"second number": {
+			json: "1\n2",
+			want: "invalid character '2' after top-level value at line 2, column 1",
+		},
+		"trailing white space": {
+			json: "{\"a\": {\"type\": \"fixed\"}}\n",
+		},
+		"empty": {
+			json: " ",
+			want: "unexpected end of JSON input at line 1, column 2",
+		},
+		"not json": {
+			json: `spark_version = "fixed"`,
+			want: "invalid character 's' looking for beginning of value at line 1, column 1",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := checkJSON(tt.json)
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicyDefinitionSemanticEquals(t *testing.T) {
+	config := newPolicyDefinitionValue("{\n  \"spark_version\": {\"type\": \"fixed\", \"value\": \"14.3.x-scala2.12\"},\n  \"autotermination_minutes\": {\"type\": \"range\", \"maxValue\": 120}\n}")
+	api := newPolicyDefinitionValue(`{"autotermination_minutes":{"maxValue":120,"type":"range"},"spark_version":{"type":"fixed","value":"14.3.x-scala2.12"}}`)
+
+	equal, diags := config.StringSemanticEquals(context.Background(), api)
+	if diags.HasError() || !equal {
+		t.Errorf("expected the definitions to be equal, got %t, %v", equal, diags)
+	}
+
+	equal, _ = config.StringSemanticEquals(context.Background(), newPolicyDefinitionValue(`{"spark_version":{"type":"unlimited"}}`))
+	if equal {
+		t.Errorf("expected different definitions not to be equal")
+	}
+}
+
+func TestPolicyDefinitionValidator(t *testing.T) {
+	tests := map[string]struct {
+		json string
+		want []string
+	}{
+		"valid": {
+			json: `{"spark_version": {"type": "fixed", "value": "14.3.x-scala2.12"}}`,
+		},
+		"malformed": {
+			json: `{"spark_version": {"type": "fixed", "value": }}`,
+		},
+		"not an object": {
+			json: `["spark_version"]`,
+			want: []string{"Invalid Policy Definition"},
+		},
+		"rule without type": {
+			json: `{"spark_version": {"value": "14.3.x-scala2.12"}}`,
+			want: []string{"Invalid Policy Rule"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			policyDefinitionValidator{}.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("definition"),
+				ConfigValue: types.StringValue(tt.json),
+			}, resp)
+
+			var got []string
+			for _, d := range resp.Diagnostics {
+				got = append(got, d.Summary())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+)
+
+// clusterPolicy is a cluster policy whose rules are checked against the
+// planned clusters and jobs that refer to it.
+type clusterPolicy struct {
+	policyID string
+	name     string
+	rules    map[string]client.PolicyRule
+}
+
+// policyAttribute is a cluster attribute that policies can restrict.
+type policyAttribute struct {
+	// name is the path of the attribute in policy definitions, such as
+	// autoscale.max_workers or custom_tags.team.
+	name  string
+	path  path.Path
+	value attr.Value
+}
+
+// fetchClusterPolicy looks up the policy a cluster refers to at
+// policyIDPath. Failures are warnings, since they only prevent the policy
+// from being checked before apply.
+func fetchClusterPolicy(ctx context.Context, api clusterPolicyAPI, policyID string, policyIDPath path.Path, diags *diag.Diagnostics) *clusterPolicy {
+	policy, err := api.FindClusterPolicy(ctx, policyID)
+	if err != nil {
+		diags.AddAttributeWarning(
+			policyIDPath,
+			"Unable to Check Cluster Policy",
+			fmt.Sprintf("The cluster policy %s could not be read, so the cluster is only checked against it during apply: %s", policyID, err),
+		)
+		return nil
+	}
+
+	rules, err := parsePolicyDefinition(policy.Definition)
+	if err != nil {
+		diags.AddAttributeWarning(
+			policyIDPath,
+			"Unable to Check Cluster Policy",
+			fmt.Sprintf("The definition of the cluster policy %s could not be parsed, so the cluster is only checked against it during apply: %s", policyID, err),
+		)
+		return nil
+	}
+
+	return &clusterPolicy{policyID: policyID, name: policy.Name, rules: rules}
+}
+
+// addUnknownPolicyWarning warns that the policy at policyIDPath is not known
+// yet, usually because it is created in the same plan, so the cluster is only
+// checked against it during apply.
+func addUnknownPolicyWarning(diags *diag.Diagnostics, policyIDPath path.Path) {
+	diags.AddAttributeWarning(
+		policyIDPath,
+		"Cluster Policy Not Checked",
+		"The cluster policy is not known yet, usually because it is created in the same plan, so the cluster is only checked against it during apply. "+
+			"Policy default values are not planned either.",
+	)
+}
+
+func parsePolicyDefinition(definition string) (map[string]client.PolicyRule, error) {
+	dec := json.NewDecoder(strings.NewReader(definition))
+	dec.UseNumber()
+
+	var rules map[string]client.PolicyRule
+	if err := dec.Decode(&rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// emptyPolicyDefinition reports whether a definition has no rules.
+func emptyPolicyDefinition(definition string) bool {
+	rules, err := parsePolicyDefinition(definition)
+	return err == nil && len(rules) == 0
+}
+
+// evaluate reports an error for each attribute that breaks a rule of the
+// policy. Null and unknown attributes are not checked.
+func (p *clusterPolicy) evaluate(attrs []policyAttribute, diags *diag.Diagnostics) {
+	for _, a := range attrs {
+		rule, ok := p.rules[a.name]
+		if !ok {
+			continue
+		}
+		value, ok := policyAttributeString(a.value)
+		if !ok {
+			continue
+		}
+
+		if reason := policyRuleViolation(rule, value); reason != "" {
+			diags.AddAttributeError(
+				a.path,
+				"Cluster Policy Violation",
+				fmt.Sprintf("%s %s under the cluster policy %q (%s), got %q.", a.name, reason, p.name, p.policyID, value),
+			)
+		}
+	}
+}
+
+// defaultValue returns the value the policy gives to an attribute that the
+// cluster does not set.
+func (p *clusterPolicy) defaultValue(name string) (string, bool) {
+	rule, ok := p.rules[name]
+	switch {
+	case !ok:
+		return "", false
+	case rule.Type == client.PolicyRuleFixed:
+		return policyValueString(rule.Value), true
+	case rule.DefaultValue != nil:
+		return policyValueString(rule.DefaultValue), true
+	}
+	return "", false
+}
+
+// policyRuleViolation returns why value breaks rule, or "" when the rule
+// allows it.
+func policyRuleViolation(rule client.PolicyRule, value string) string {
+	switch rule.Type {
+	case client.PolicyRuleFixed:
+		if want := policyValueString(rule.Value); value != want {
+			return fmt.Sprintf("must be %q", want)
+		}
+	case client.PolicyRuleForbidden:
+		return "must not be set"
+	case client.PolicyRuleAllowlist, client.PolicyRuleBlocklist:
+		values := make([]string, 0, len(rule.Values))
+		for _, v := range rule.Values {
+			values = append(values, strconv.Quote(policyValueString(v)))
+		}
+		allowed := slices.Contains(values, strconv.Quote(value))
+		if rule.Type == client.PolicyRuleAllowlist && !allowed {
+			return "must be one of " + strings.Join(values, ", ")
+		}
+		if rule.Type == client.PolicyRuleBlocklist && allowed {
+			return "must not be one of " + strings.Join(values, ", ")
+		}
+	case client.PolicyRuleRange:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return "must be a number"
+		}
+		if rule.MinValue != nil && f < *rule.MinValue {
+			return fmt.Sprintf("must be at least %s", strconv.FormatFloat(*rule.MinValue, 'f', -1, 64))
+		}
+		if rule.MaxValue != nil && f > *rule.MaxValue {
+			return fmt.Sprintf("must be at most %s", strconv.FormatFloat(*rule.MaxValue, 'f', -1, 64))
+		}
+	case client.PolicyRuleRegex:
+		re, err := compilePolicyPattern(rule.Pattern)
+		if err == nil && !re.MatchString(value) {
+			return fmt.Sprintf("must match %q", rule.Pattern)
+		}
+	}
+	return ""
+}
+
+// policyValueString formats a value of a policy definition as the attribute
+// values it is compared with.
+func policyValueString(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return strconv.FormatFloat(f, 'f', -1, 64)
+		}
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	case nil:
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+func policyAttributeString(v attr.Value) (string, bool) {
+	if v == nil || v.IsNull() || v.IsUnknown() {
+		return "", false
+	}
+
+	switch v := v.(type) {
+	case types.String:
+		return v.ValueString(), true
+	case types.Int64:
+		return strconv.FormatInt(v.ValueInt64(), 10), true
+	case types.Bool:
+		return strconv.FormatBool(v.ValueBool()), true
+	case types.Float64:
+		return strconv.FormatFloat(v.ValueFloat64(), 'f', -1, 64), true
+	}
+	return "", false
+}
+
+// clusterSpecPolicyAttributes returns the attributes of the new_cluster
+// block at base.
+func clusterSpecPolicyAttributes(spec ClusterSpecModel, base path.Path) []policyAttribute {
+	attrs := []policyAttribute{
+		{name: "spark_version", path: base.AtName("spark_version"), value: spec.SparkVersion},
+		{name: "node_type_id", path: base.AtName("node_type_id"), value: spec.NodeTypeID},
+		{name: "num_workers", path: base.AtName("num_workers"), value: spec.NumWorkers},
+	}
+	return append(attrs, autoScalePolicyAttributes(spec.Autoscale, base)...)
+}
+
+func autoScalePolicyAttributes(scales []AutoScaleModel, base path.Path) []policyAttribute {
+	if len(scales) == 0 {
+		return nil
+	}
+
+	scale := base.AtName("autoscale").AtListIndex(0)
+	return []policyAttribute{
+		{name: "autoscale.min_workers", path: scale.AtName("min_workers"), value: scales[0].MinWorkers},
+		{name: "autoscale.max_workers", path: scale.AtName("max_workers"), value: scales[0].MaxWorkers},
+	}
+}
+
+// mapPolicyAttributes returns the entries of a map attribute such as
+// custom_tags, which policies restrict by key.
+func mapPolicyAttributes(name string, m types.Map, p path.Path) []policyAttribute {
+	var attrs []policyAttribute
+	for key, value := range m.Elements() {
+		attrs = append(attrs, policyAttribute{name: name + "." + key, path: p.AtMapKey(key), value: value})
+	}
+	return attrs
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestClusterPolicyEvaluate(t *testing.T) {
+	rules, err := parsePolicyDefinition(`{
+		"spark_version": {"type": "fixed", "value": "14.3.x-scala2.12"},
+		"node_type_id": {"type": "allowlist", "values": ["b3-8", "b3-16"], "defaultValue": "b3-8"},
+		"num_workers": {"type": "range", "minValue": 1, "maxValue": 8},
+		"autoscale.max_workers": {"type": "range", "maxValue": 10.0},
+		"autotermination_minutes": {"type": "fixed", "value": 30},
+		"instance_pool_id": {"type": "forbidden"},
+		"cluster_name": {"type": "regex", "pattern": "team-[a-z]+"},
+		"custom_tags.env": {"type": "blocklist", "values": ["prod"]}
+	}`)
+	if err != nil {
+		t.Fatalf("parsing definition: %s", err)
+	}
+	policy := &clusterPolicy{policyID: "ABC123", name: "small", rules: rules}
+
+	tests := map[string]struct {
+		attr policyAttribute
+		want bool
+	}{
+		"fixed":              {attr: policyAttribute{name: "spark_version", value: types.StringValue("14.3.x-scala2.12")}},
+		"fixed differs":      {attr: policyAttribute{name: "spark_version", value: types.StringValue("13.3.x-scala2.12")}, want: true},
+		"fixed number":       {attr: policyAttribute{name: "autotermination_minutes", value: types.Int64Value(30)}},
+		"allowlist":          {attr: policyAttribute{name: "node_type_id", value: types.StringValue("b3-16")}},
+		"not in allowlist":   {attr: policyAttribute{name: "node_type_id", value: types.StringValue("b3-32")}, want: true},
+		"in range":           {attr: policyAttribute{name: "num_workers", value: types.Int64Value(8)}},
+		"below range":        {attr: policyAttribute{name: "num_workers", value: types.Int64Value(0)}, want: true},
+		"above range":        {attr: policyAttribute{name: "autoscale.max_workers", value: types.Int64Value(11)}, want: true},
+		"forbidden":          {attr: policyAttribute{name: "instance_pool_id", value: types.StringValue("pool")}, want: true},
+		"regex":              {attr: policyAttribute{name: "cluster_name", value: types.StringValue("team-data")}},
+		"regex partial":      {attr: policyAttribute{name: "cluster_name", value: types.StringValue("my-team-data")}, want: true},
+		"blocklist":          {attr: policyAttribute{name: "custom_tags.env", value: types.StringValue("prod")}, want: true},
+		"no rule":            {attr: policyAttribute{name: "spark_conf.x", value: types.StringValue("y")}},
+		"unknown not forced": {attr: policyAttribute{name: "spark_version", value: types.StringUnknown()}},
+		"null not forbidden": {attr: policyAttribute{name: "instance_pool_id", value: types.StringNull()}},
+	}
+
+	for name, tt := range tests {
+		var diags diag.Diagnostics
+		tt.attr.path = path.Root(tt.attr.name)
+		policy.evaluate([]policyAttribute{tt.attr}, &diags)
+		if diags.HasError() != tt.want {
+			t.Errorf("%s: got errors %v, want errors %t", name, diags, tt.want)
+		}
+	}
+
+	for name, want := range map[string]string{"node_type_id": "b3-8", "autotermination_minutes": "30"} {
+		if got, ok := policy.defaultValue(name); !ok || got != want {
+			t.Errorf("default of %s: got %q, want %q", name, got, want)
+		}
+	}
+	if _, ok := policy.defaultValue("num_workers"); ok {
+		t.Errorf("expected no default for num_workers")
+	}
+}
+
+// A job cluster leaves the attributes it does not set to the policy defaults
+// applied by Databricks: none of them can be planned, and the null values
+// are not checked against the policy.
+func TestClusterSpecPolicyDefaults(t *testing.T) {
+	for name, attribute := range clusterSpecBlock().NestedObject.Attributes {
+		if attribute.IsComputed() {
+			t.Errorf("new_cluster attribute %s is computed, plan its policy default in the job ModifyPlan", name)
+		}
+	}
+
+	rules, err := parsePolicyDefinition(`{
+		"num_workers": {"type": "range", "minValue": 2, "maxValue": 8, "defaultValue": 4},
+		"autoscale.max_workers": {"type": "fixed", "value": 6}
+	}`)
+	if err != nil {
+		t.Fatalf("parsing definition: %s", err)
+	}
+	policy := &clusterPolicy{policyID: "ABC123", name: "jobs", rules: rules}
+
+	spec := ClusterSpecModel{
+		SparkVersion: types.StringValue("14.3.x-scala2.12"),
+		NodeTypeID:   types.StringValue("b3-8"),
+		NumWorkers:   types.Int64Null(),
+		PolicyID:     types.StringValue("ABC123"),
+	}
+
+	var diags diag.Diagnostics
+	policy.evaluate(clusterSpecPolicyAttributes(spec, path.Root("new_cluster").AtListIndex(0)), &diags)
+	if diags.HasError() {
+		t.Errorf("expected a job cluster relying on the policy defaults to be valid, got %v", diags)
+	}
+}
@@ -0,0 +1,314 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+)
+
+// PolicyRuleModel describes a rule block of a cluster policy.
+type PolicyRuleModel struct {
+	Path         types.String  `tfsdk:"path"`
+	Type         types.String  `tfsdk:"type"`
+	Value        types.String  `tfsdk:"value"`
+	Values       types.List    `tfsdk:"values"`
+	MinValue     types.Float64 `tfsdk:"min_value"`
+	MaxValue     types.Float64 `tfsdk:"max_value"`
+	DefaultValue types.String  `tfsdk:"default_value"`
+	IsOptional   types.Bool    `tfsdk:"is_optional"`
+}
+
+var policyRuleTypes = []string{
+	client.PolicyRuleFixed,
+	client.PolicyRuleForbidden,
+	client.PolicyRuleAllowlist,
+	client.PolicyRuleBlocklist,
+	client.PolicyRuleRange,
+	client.PolicyRuleRegex,
+	client.PolicyRuleUnlimited,
+}
+
+// policyNumberAttributes and policyBoolAttributes are the cluster attributes
+// whose values are not strings. Rule values are strings in the configuration
+// and are compiled into the JSON type the policy engine compares with.
+var (
+	policyNumberAttributes = map[string]bool{
+		"num_workers":             true,
+		"autoscale.min_workers":   true,
+		"autoscale.max_workers":   true,
+		"autotermination_minutes": true,
+		"dbus_per_hour":           true,
+	}
+	policyBoolAttributes = map[string]bool{
+		"enable_elastic_disk":          true,
+		"enable_local_disk_encryption": true,
+	}
+)
+
+func policyRuleBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: "Rule of the policy, compiled into definition. Conflicts with definition",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"path": schema.StringAttribute{
+					Description: "Cluster attribute the rule applies to, such as spark_version, autoscale.max_workers or custom_tags.team",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"type": schema.StringAttribute{
+					Description: "Rule type: fixed, forbidden, allowlist, blocklist, range, regex or unlimited",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(policyRuleTypes...),
+					},
+				},
+				"value": schema.StringAttribute{
+					Description: "Value of a fixed rule, or pattern of a regex rule",
+					Optional:    true,
+				},
+				"values": schema.ListAttribute{
+					Description: "Values of an allowlist or blocklist rule",
+					Optional:    true,
+					ElementType: types.StringType,
+				},
+				"min_value": schema.Float64Attribute{
+					Description: "Minimum of a range rule",
+					Optional:    true,
+				},
+				"max_value": schema.Float64Attribute{
+					Description: "Maximum of a range rule",
+					Optional:    true,
+				},
+				"default_value": schema.StringAttribute{
+					Description: "Value used when the cluster does not set the attribute",
+					Optional:    true,
+				},
+				"is_optional": schema.BoolAttribute{
+					Description: "Whether the cluster may leave the attribute unset",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+// validatePolicyRules checks that the fields of each rule match its type.
+// Unknown values are not checked.
+func validatePolicyRules(ctx context.Context, rules []PolicyRuleModel, diags *diag.Diagnostics) {
+	seen := map[string]bool{}
+
+	for i, rule := range rules {
+		base := path.Root("rule").AtListIndex(i)
+		ruleType := rule.Type.ValueString()
+		rulePath := rule.Path.ValueString()
+
+		if !rule.Path.IsUnknown() {
+			if seen[rulePath] {
+				diags.AddAttributeError(base.AtName("path"), "Duplicate Policy Rule",
+					fmt.Sprintf("Only one rule can apply to %s.", rulePath))
+			}
+			seen[rulePath] = true
+		}
+		if rule.Type.IsUnknown() {
+			continue
+		}
+
+		// fields lists the fields each type accepts, besides path and type.
+		fields := map[string][]string{
+			client.PolicyRuleFixed:     {"value"},
+			client.PolicyRuleForbidden: {},
+			client.PolicyRuleAllowlist: {"values", "default_value", "is_optional"},
+			client.PolicyRuleBlocklist: {"values", "default_value", "is_optional"},
+			client.PolicyRuleRange:     {"min_value", "max_value", "default_value", "is_optional"},
+			client.PolicyRuleRegex:     {"value", "default_value", "is_optional"},
+			client.PolicyRuleUnlimited: {"default_value", "is_optional"},
+		}[ruleType]
+		set := map[string]bool{
+			"value":         !rule.Value.IsNull(),
+			"values":        !rule.Values.IsNull(),
+			"min_value":     !rule.MinValue.IsNull(),
+			"max_value":     !rule.MaxValue.IsNull(),
+			"default_value": !rule.DefaultValue.IsNull(),
+			"is_optional":   !rule.IsOptional.IsNull(),
+		}
+		for _, name := range []string{"value", "values", "min_value", "max_value", "default_value", "is_optional"} {
+			if set[name] && !slices.Contains(fields, name) {
+				diags.AddAttributeError(base.AtName(name), "Invalid Policy Rule",
+					fmt.Sprintf("%s cannot be set on a %s rule.", name, ruleType))
+			}
+		}
+
+		switch ruleType {
+		case client.PolicyRuleFixed:
+			if !set["value"] {
+				diags.AddAttributeError(base.AtName("value"), "Invalid Policy Rule", "A fixed rule needs a value.")
+			}
+		case client.PolicyRuleAllowlist, client.PolicyRuleBlocklist:
+			if !set["values"] || (!rule.Values.IsUnknown() && len(rule.Values.Elements()) == 0) {
+				diags.AddAttributeError(base.AtName("values"), "Invalid Policy Rule",
+					fmt.Sprintf("An %s rule needs at least one value.", ruleType))
+			}
+		case client.PolicyRuleRange:
+			switch {
+			case !set["min_value"] && !set["max_value"]:
+				diags.AddAttributeError(base.AtName("min_value"), "Invalid Policy Rule", "A range rule needs a min_value, a max_value or both.")
+			case set["min_value"] && set["max_value"] && !rule.MinValue.IsUnknown() && !rule.MaxValue.IsUnknown() &&
+				rule.MinValue.ValueFloat64() > rule.MaxValue.ValueFloat64():
+				diags.AddAttributeError(base.AtName("max_value"), "Invalid Policy Rule", "max_value must not be lower than min_value.")
+			}
+		case client.PolicyRuleRegex:
+			if !set["value"] {
+				diags.AddAttributeError(base.AtName("value"), "Invalid Policy Rule", "A regex rule needs the pattern as value.")
+			} else if !rule.Value.IsUnknown() {
+				if _, err := compilePolicyPattern(rule.Value.ValueString()); err != nil {
+					diags.AddAttributeError(base.AtName("value"), "Invalid Policy Rule", fmt.Sprintf("Invalid pattern: %s.", err))
+				}
+			}
+		}
+
+		if rule.Path.IsUnknown() {
+			continue
+		}
+
+		// Values must have the type of the attribute, and defaults must be
+		// allowed by the rule.
+		if ruleType != client.PolicyRuleRegex && !rule.Value.IsNull() && !rule.Value.IsUnknown() {
+			if _, err := policyValue(rulePath, rule.Value.ValueString()); err != nil {
+				diags.AddAttributeError(base.AtName("value"), "Invalid Policy Rule", err.Error()+".")
+			}
+		}
+		var values []string
+		valuesKnown := !rule.Values.IsUnknown()
+		for j, e := range rule.Values.Elements() {
+			v, ok := e.(types.String)
+			if !ok || v.IsUnknown() {
+				valuesKnown = false
+				continue
+			}
+			values = append(values, v.ValueString())
+			if _, err := policyValue(rulePath, v.ValueString()); err != nil {
+				diags.AddAttributeError(base.AtName("values").AtListIndex(j), "Invalid Policy Rule", err.Error()+".")
+			}
+		}
+		if rule.DefaultValue.IsNull() || rule.DefaultValue.IsUnknown() {
+			continue
+		}
+		def := rule.DefaultValue.ValueString()
+		n, err := policyValue(rulePath, def)
+		if err != nil {
+			diags.AddAttributeError(base.AtName("default_value"), "Invalid Policy Rule", err.Error()+".")
+			continue
+		}
+		switch {
+		case ruleType == client.PolicyRuleAllowlist && valuesKnown && !slices.Contains(values, def):
+			diags.AddAttributeError(base.AtName("default_value"), "Invalid Policy Rule", "default_value must be one of the values of the allowlist.")
+		case ruleType == client.PolicyRuleBlocklist && slices.Contains(values, def):
+			diags.AddAttributeError(base.AtName("default_value"), "Invalid Policy Rule", "default_value must not be one of the values of the blocklist.")
+		case ruleType == client.PolicyRuleRange:
+			f, ok := n.(json.Number)
+			if !ok {
+				diags.AddAttributeError(base.AtName("default_value"), "Invalid Policy Rule", "The default_value of a range rule must be a number.")
+				break
+			}
+			x, _ := f.Float64()
+			if (!rule.MinValue.IsNull() && x < rule.MinValue.ValueFloat64()) || (!rule.MaxValue.IsNull() && x > rule.MaxValue.ValueFloat64()) {
+				diags.AddAttributeError(base.AtName("default_value"), "Invalid Policy Rule", "default_value must be within min_value and max_value.")
+			}
+		case ruleType == client.PolicyRuleRegex && !rule.Value.IsUnknown():
+			if re, err := compilePolicyPattern(rule.Value.ValueString()); err == nil && !re.MatchString(def) {
+				diags.AddAttributeError(base.AtName("default_value"), "Invalid Policy Rule", "default_value must match the whole pattern.")
+			}
+		}
+	}
+}
+
+// compilePolicyPattern compiles the pattern of a regex rule, which must match
+// the whole value.
+func compilePolicyPattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
+
+// compilePolicyRules returns the definition JSON of the rules, with sorted
+// keys. It returns false when a value is unknown.
+func compilePolicyRules(ctx context.Context, rules []PolicyRuleModel, diags *diag.Diagnostics) (string, bool) {
+	definition := make(map[string]client.PolicyRule, len(rules))
+
+	for _, rule := range rules {
+		for _, v := range []interface{ IsUnknown() bool }{
+			rule.Path, rule.Type, rule.Value, rule.Values, rule.MinValue, rule.MaxValue, rule.DefaultValue, rule.IsOptional,
+		} {
+			if v.IsUnknown() {
+				return "", false
+			}
+		}
+		for _, e := range rule.Values.Elements() {
+			if e.IsUnknown() {
+				return "", false
+			}
+		}
+
+		rulePath := rule.Path.ValueString()
+		compiled := client.PolicyRule{
+			Type:       rule.Type.ValueString(),
+			MinValue:   rule.MinValue.ValueFloat64Pointer(),
+			MaxValue:   rule.MaxValue.ValueFloat64Pointer(),
+			IsOptional: rule.IsOptional.ValueBool(),
+		}
+		if !rule.Value.IsNull() {
+			if compiled.Type == client.PolicyRuleRegex {
+				compiled.Pattern = rule.Value.ValueString()
+			} else {
+				compiled.Value, _ = policyValue(rulePath, rule.Value.ValueString())
+			}
+		}
+		for _, v := range listToStrings(ctx, rule.Values, diags) {
+			value, _ := policyValue(rulePath, v)
+			compiled.Values = append(compiled.Values, value)
+		}
+		if !rule.DefaultValue.IsNull() {
+			compiled.DefaultValue, _ = policyValue(rulePath, rule.DefaultValue.ValueString())
+		}
+		definition[rulePath] = compiled
+	}
+
+	b, err := json.Marshal(definition)
+	if err != nil {
+		diags.AddError("Policy Rule Error", fmt.Sprintf("Unable to compile the policy rules: %s", err))
+		return "", false
+	}
+	return string(b), true
+}
+
+// policyValue converts a rule value written in the configuration into the
+// JSON type of the attribute at path.
+func policyValue(attribute, s string) (any, error) {
+	switch {
+	case policyNumberAttributes[attribute]:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+			return nil, fmt.Errorf("%s is a number, got %q", attribute, s)
+		}
+		return json.Number(strconv.FormatFloat(f, 'f', -1, 64)), nil
+	case policyBoolAttributes[attribute]:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s is true or false, got %q", attribute, s)
+		}
+		return b, nil
+	}
+	return s, nil
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testPolicyRule returns a rule with every optional field null.
+func testPolicyRule(path, ruleType string) PolicyRuleModel {
+	return PolicyRuleModel{
+		Path:         types.StringValue(path),
+		Type:         types.StringValue(ruleType),
+		Value:        types.StringNull(),
+		Values:       types.ListNull(types.StringType),
+		MinValue:     types.Float64Null(),
+		MaxValue:     types.Float64Null(),
+		DefaultValue: types.StringNull(),
+		IsOptional:   types.BoolNull(),
+	}
+}
+
+func testStringList(values ...string) types.List {
+	l, _ := types.ListValueFrom(context.Background(), types.StringType, values)
+	return l
+}
+
+func TestValidatePolicyRules(t *testing.T) {
+	tests := map[string]struct {
+		rule  func(r *PolicyRuleModel)
+		path  string
+		rtype string
+		want  []string
+	}{
+		"fixed": {
+			path: "spark_version", rtype: "fixed",
+			rule: func(r *PolicyRuleModel) { r.Value = types.StringValue("14.3.x-scala2.12") },
+		},
+		"fixed without value": {
+			path: "spark_version", rtype: "fixed",
+			rule: func(r *PolicyRuleModel) {},
+			want: []string{`rule[0].value`},
+		},
+		"forbidden with values": {
+			path: "instance_pool_id", rtype: "forbidden",
+			rule: func(r *PolicyRuleModel) { r.Values = testStringList("a") },
+			want: []string{`rule[0].values`},
+		},
+		"allowlist of numbers": {
+			path: "num_workers", rtype: "allowlist",
+			rule: func(r *PolicyRuleModel) { r.Values = testStringList("1", "two") },
+			want: []string{`rule[0].values[1]`},
+		},
+		"allowlist default not allowed": {
+			path: "node_type_id", rtype: "allowlist",
+			rule: func(r *PolicyRuleModel) {
+				r.Values = testStringList("b3-8", "b3-16")
+				r.DefaultValue = types.StringValue("b3-32")
+			},
+			want: []string{`rule[0].default_value`},
+		},
+		"range": {
+			path: "autotermination_minutes", rtype: "range",
+			rule: func(r *PolicyRuleModel) {
+				r.MinValue = types.Float64Value(10)
+				r.MaxValue = types.Float64Value(120)
+				r.DefaultValue = types.StringValue("60")
+			},
+		},
+		"inverted range": {
+			path: "autotermination_minutes", rtype: "range",
+			rule: func(r *PolicyRuleModel) {
+				r.MinValue = types.Float64Value(120)
+				r.MaxValue = types.Float64Value(10)
+			},
+			want: []string{`rule[0].max_value`},
+		},
+		"invalid regex": {
+			path: "cluster_name", rtype: "regex",
+			rule: func(r *PolicyRuleModel) { r.Value = types.StringValue("team-(") },
+			want: []string{`rule[0].value`},
+		},
+		"regex default": {
+			path: "cluster_name", rtype: "regex",
+			rule: func(r *PolicyRuleModel) {
+				r.Value = types.StringValue("team-[a-z]+")
+				r.DefaultValue = types.StringValue("team-ax")
+			},
+		},
+		"regex default partial match": {
+			path: "cluster_name", rtype: "regex",
+			rule: func(r *PolicyRuleModel) {
+				r.Value = types.StringValue("team-[a-z]+")
+				r.DefaultValue = types.StringValue("xteam-ax")
+			},
+			want: []string{`rule[0].default_value`},
+		},
+		"unknown values": {
+			path: "node_type_id", rtype: "allowlist",
+			rule: func(r *PolicyRuleModel) {
+				r.Values = types.ListUnknown(types.StringType)
+				r.DefaultValue = types.StringValue("b3-8")
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			rule := testPolicyRule(tt.path, tt.rtype)
+			tt.rule(&rule)
+
+			var diags diag.Diagnostics
+			validatePolicyRules(context.Background(), []PolicyRuleModel{rule}, &diags)
+
+			var got []string
+			for _, d := range diags.Errors() {
+				got = append(got, d.(diag.DiagnosticWithPath).Path().String())
+			}
+			if len(got) != len(tt.want) || (len(got) > 0 && got[0] != tt.want[0]) {
+				t.Errorf("got errors at %v, want %v: %v", got, tt.want, diags)
+			}
+		})
+	}
+}
+
+func TestCompilePolicyRules(t *testing.T) {
+	version := testPolicyRule("spark_version", "fixed")
+	version.Value = types.StringValue("14.3.x-scala2.12")
+	workers := testPolicyRule("autoscale.max_workers", "range")
+	workers.MaxValue = types.Float64Value(10)
+	workers.DefaultValue = types.StringValue("4")
+	disk := testPolicyRule("enable_elastic_disk", "fixed")
+	disk.Value = types.StringValue("false")
+	name := testPolicyRule("cluster_name", "regex")
+	name.Value = types.StringValue("^team-")
+	name.IsOptional = types.BoolValue(true)
+
+	var diags diag.Diagnostics
+	got, ok := compilePolicyRules(context.Background(), []PolicyRuleModel{version, workers, disk, name}, &diags)
+	want := `{"autoscale.max_workers":{"type":"range","maxValue":10,"defaultValue":4},` +
+		`"cluster_name":{"type":"regex","pattern":"^team-","isOptional":true},` +
+		`"enable_elastic_disk":{"type":"fixed","value":false},` +
+		`"spark_version":{"type":"fixed","value":"14.3.x-scala2.12"}}`
+	if !ok || diags.HasError() || got != want {
+		t.Errorf("got %s (%t, %v), want %s", got, ok, diags, want)
+	}
+
+	version.Value = types.StringUnknown()
+	if _, ok := compilePolicyRules(context.Background(), []PolicyRuleModel{version}, &diags); ok {
+		t.Errorf("expected rules with unknown values not to compile")
+	}
+}
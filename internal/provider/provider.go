@@ -2,15 +2,27 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"os"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/ovh/go-ovh/ovh"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/databricks"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/transport"
 )
 
 var _ provider.Provider = &DatabricksOVHProvider{}
@@ -20,19 +32,175 @@ type DatabricksOVHProvider struct {
 }
 
 type DatabricksOVHProviderModel struct {
-	OVHEndpoint           types.String `tfsdk:"ovh_endpoint"`
-	OVHApplicationKey     types.String `tfsdk:"ovh_application_key"`
-	OVHApplicationSecret  types.String `tfsdk:"ovh_application_secret"`
-	OVHConsumerKey        types.String `tfsdk:"ovh_consumer_key"`
-	OVHProjectID          types.String `tfsdk:"ovh_project_id"`
-	DatabricksAccountID   types.String `tfsdk:"databricks_account_id"`
-	DatabricksUsername    types.String `tfsdk:"databricks_username"`
-	DatabricksPassword    types.String `tfsdk:"databricks_password"`
-	DatabricksToken       types.String `tfsdk:"databricks_token"`
+	OVHEndpoint            types.String `tfsdk:"ovh_endpoint"`
+	OVHApplicationKey      types.String `tfsdk:"ovh_application_key"`
+	OVHApplicationSecret   types.String `tfsdk:"ovh_application_secret"`
+	OVHConsumerKey         types.String `tfsdk:"ovh_consumer_key"`
+	OVHClientID            types.String `tfsdk:"ovh_client_id"`
+	OVHClientSecret        types.String `tfsdk:"ovh_client_secret"`
+	OVHProjectID           types.String `tfsdk:"ovh_project_id"`
+	DatabricksAccountID    types.String `tfsdk:"databricks_account_id"`
+	DatabricksUsername     types.String `tfsdk:"databricks_username"`
+	DatabricksPassword     types.String `tfsdk:"databricks_password"`
+	DatabricksToken        types.String `tfsdk:"databricks_token"`
+	DatabricksClientID     types.String `tfsdk:"databricks_client_id"`
+	DatabricksClientSecret types.String `tfsdk:"databricks_client_secret"`
+	MaxRetries             types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait           types.String `tfsdk:"retry_max_wait"`
+	RequestTimeout         types.String `tfsdk:"request_timeout"`
+	HTTPProxy              types.String `tfsdk:"http_proxy"`
+	CABundleFile           types.String `tfsdk:"ca_bundle_file"`
+	InsecureSkipVerify     types.Bool   `tfsdk:"insecure_skip_verify"`
+
+	SkipCredentialsValidation types.Bool `tfsdk:"skip_credentials_validation"`
 }
 
 type Config struct {
 	OVHClient *ovh.Client
+	API       *client.Client
+	ProjectID string
+
+	// DatabricksCredentials authenticate the resources that set workspace_url
+	// against the REST API of their workspace.
+	DatabricksCredentials databricks.Credentials
+	DatabricksAccountID   string
+
+	// DatabricksHTTPClient sends the requests to the workspace APIs and the
+	// OAuth token endpoints.
+	DatabricksHTTPClient *http.Client
+
+	// tokenSources caches the OAuth token sources by token endpoint, so that
+	// all resources share their tokens.
+	tokenSourcesMu sync.Mutex
+	tokenSources   map[string]*databricks.TokenSource
+}
+
+// workspaceCredentials returns the credentials for the workspace at host.
+// OAuth tokens come from the account token endpoint when
+// databricks_account_id is set, otherwise from the one of the workspace.
+func (c *Config) workspaceCredentials(host string) databricks.Credentials {
+	creds := c.DatabricksCredentials
+	if creds.AuthType() != databricks.AuthTypeOAuthM2M {
+		return creds
+	}
+
+	tokenURL := databricks.WorkspaceTokenURL(host)
+	if c.DatabricksAccountID != "" {
+		tokenURL = databricks.AccountTokenURL(c.DatabricksAccountID)
+	}
+
+	c.tokenSourcesMu.Lock()
+	defer c.tokenSourcesMu.Unlock()
+
+	if c.tokenSources == nil {
+		c.tokenSources = make(map[string]*databricks.TokenSource)
+	}
+	if c.tokenSources[tokenURL] == nil {
+		c.tokenSources[tokenURL] = databricks.NewTokenSource(tokenURL, creds.ClientID, creds.ClientSecret, c.DatabricksHTTPClient)
+	}
+	creds.TokenSource = c.tokenSources[tokenURL]
+
+	return creds
+}
+
+// projectID resolves the OVH Public Cloud project of a resource: its own
+// project_id when set, otherwise the provider default.
+func (c *Config) projectID(v types.String) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !v.IsNull() && !v.IsUnknown() && v.ValueString() != "" {
+		return v.ValueString(), diags
+	}
+
+	if c.ProjectID == "" {
+		diags.AddAttributeError(
+			path.Root("project_id"),
+			"Missing OVH Project ID",
+			"The OVH Public Cloud project could not be determined. Set project_id on the resource, "+
+				"ovh_project_id on the provider, or the OVH_CLOUD_PROJECT_SERVICE environment variable.",
+		)
+	}
+
+	return c.ProjectID, diags
+}
+
+// importedPrivateKey is the private state key marking an imported resource
+// until the Read that follows the import.
+const importedPrivateKey = "imported"
+
+// markImported marks the resource as imported, so that the Read that follows
+// fills in attributes it otherwise only keeps when they are configured, such
+// as custom_tags.
+func markImported(ctx context.Context, resp *resource.ImportStateResponse) {
+	// The framework always sets Private; responses built in tests do not.
+	if resp.Private == nil {
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateKey, []byte("true"))...)
+}
+
+// readingImport reports whether the Read follows an import, and clears the
+// mark of markImported.
+func readingImport(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) bool {
+	imported, diags := req.Private.GetKey(ctx, importedPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if len(imported) == 0 {
+		return false
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateKey, nil)...)
+	return true
+}
+
+// importStateWithProject imports a resource from either "<id>" or
+// "<project_id>/<id>". Without a project the provider default is used.
+func importStateWithProject(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, id, found := strings.Cut(req.ID, "/")
+	if !found {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	if projectID == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <id> or <project_id>/<id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// importStateWithKeys imports a resource identified by several keys joined
+// with "/", such as "<workspace_id>/<scope>/<key>", optionally prefixed with
+// "<project_id>/". Each key is stored in the attribute of the same name and
+// the joined keys in id.
+func importStateWithKeys(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attributes ...string) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) == len(attributes)+1 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+		parts = parts[1:]
+	}
+
+	valid := len(parts) == len(attributes)
+	for _, part := range parts {
+		valid = valid && part != ""
+	}
+	if !valid {
+		format := "<" + strings.Join(attributes, ">/<") + ">"
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: %s or <project_id>/%s. Got: %q", format, format, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strings.Join(parts, "/"))...)
+	for i, attribute := range attributes {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attribute), parts[i])...)
+	}
 }
 
 func (p *DatabricksOVHProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -45,42 +213,96 @@ func (p *DatabricksOVHProvider) Schema(ctx context.Context, req provider.SchemaR
 		Description: "The Databricks OVH provider enables management of Databricks resources on OVH cloud infrastructure.",
 		Attributes: map[string]schema.Attribute{
 			"ovh_endpoint": schema.StringAttribute{
-				Description: "OVH API endpoint",
-				Required:    true,
+				Description: "OVH API endpoint, either a name such as ovh-eu or ovh-ca, or the URL of the API, such as https://eu.api.ovh.com/1.0 or the URL of a local test server. " +
+					"Can also be set with the OVH_ENDPOINT environment variable or in the [default] section of ovh.conf. Defaults to ovh-eu",
+				Optional: true,
 			},
 			"ovh_application_key": schema.StringAttribute{
-				Description: "OVH application key",
-				Required:    true,
+				Description: "OVH application key, used with ovh_application_secret and ovh_consumer_key. Conflicts with ovh_client_id. " +
+					"Each OVH credential can also be set with its OVH_* environment variable, such as OVH_APPLICATION_KEY, or in the section of ovh.conf named after the endpoint",
+				Optional: true,
 			},
 			"ovh_application_secret": schema.StringAttribute{
 				Description: "OVH application secret",
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
 			},
 			"ovh_consumer_key": schema.StringAttribute{
 				Description: "OVH consumer key",
-				Required:    true,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"ovh_client_id": schema.StringAttribute{
+				Description: "Client ID of an OVH OAuth2 service account, used with ovh_client_secret instead of application keys",
+				Optional:    true,
+			},
+			"ovh_client_secret": schema.StringAttribute{
+				Description: "Client secret of the OVH OAuth2 service account",
+				Optional:    true,
 				Sensitive:   true,
 			},
 			"ovh_project_id": schema.StringAttribute{
-				Description: "OVH project ID",
+				Description: "OVH Public Cloud project ID (service name) used by resources that do not set project_id. Can also be set with the OVH_CLOUD_PROJECT_SERVICE environment variable",
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of retries of an API request that was throttled or failed with a transient error. Defaults to 5",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Description: "Maximum delay between two retries, as a duration such as \"30s\". The delay grows exponentially up to it. Defaults to 30s",
+				Optional:    true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Maximum duration of an API request, including its retries, as a duration such as \"3m\". Defaults to 3m",
 				Optional:    true,
 			},
+			"http_proxy": schema.StringAttribute{
+				Description: "URL of the proxy the API requests are sent through, such as http://proxy.example.com:3128. " +
+					"Defaults to the proxy of the HTTPS_PROXY and HTTP_PROXY environment variables. The OAuth2 token requests of ovh_client_id only use the environment variables",
+				Optional: true,
+			},
+			"ca_bundle_file": schema.StringAttribute{
+				Description: "Path of a PEM file of certificate authorities trusted in addition to the system ones, for example the one of a TLS-intercepting proxy. " +
+					"The OAuth2 token requests of ovh_client_id do not use it and only trust the system certificate authorities",
+				Optional: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Disable the verification of the TLS certificates of the APIs. Only meant for tests against a local server",
+				Optional:    true,
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				Description: "Skip the check of the OVH consumer key, its expiration and its access rules when the provider is configured, for example to plan without access to the OVH API. " +
+					"OAuth2 service accounts are never checked",
+				Optional: true,
+			},
 			"databricks_account_id": schema.StringAttribute{
-				Description: "Databricks account ID",
+				Description: "Databricks account ID. When set, OAuth tokens are requested from the account token endpoint and shared by all workspaces. Can also be set with the DATABRICKS_ACCOUNT_ID environment variable",
+				Optional:    true,
+			},
+			"databricks_client_id": schema.StringAttribute{
+				Description: "OAuth client ID of a Databricks service principal, used with databricks_client_secret by the resources that set workspace_url. Can also be set with the DATABRICKS_CLIENT_ID environment variable",
 				Optional:    true,
 			},
+			"databricks_client_secret": schema.StringAttribute{
+				Description: "OAuth secret of the Databricks service principal. Can also be set with the DATABRICKS_CLIENT_SECRET environment variable",
+				Optional:    true,
+				Sensitive:   true,
+			},
 			"databricks_username": schema.StringAttribute{
-				Description: "Databricks username",
+				Description: "Databricks username, used with databricks_password by the resources that set workspace_url. Can also be set with the DATABRICKS_USERNAME environment variable",
 				Optional:    true,
 			},
 			"databricks_password": schema.StringAttribute{
-				Description: "Databricks password",
+				Description: "Databricks password. Can also be set with the DATABRICKS_PASSWORD environment variable",
 				Optional:    true,
 				Sensitive:   true,
 			},
 			"databricks_token": schema.StringAttribute{
-				Description: "Databricks personal access token",
+				Description: "Databricks personal access token used by the resources that set workspace_url. Takes precedence over databricks_client_id, which takes precedence over databricks_username. Can also be set with the DATABRICKS_TOKEN environment variable",
 				Optional:    true,
 				Sensitive:   true,
 			},
@@ -119,6 +341,13 @@ func (p *DatabricksOVHProvider) Configure(ctx context.Context, req provider.Conf
 		)
 	}
 
+	if config.OVHProjectID.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown OVH Project ID",
+			"The provider cannot create the OVH API client as there is an unknown configuration value for the OVH project ID.",
+		)
+	}
+
 	if config.OVHConsumerKey.IsUnknown() {
 		resp.Diagnostics.AddError(
 			"Unknown OVH Consumer Key",
@@ -126,87 +355,191 @@ func (p *DatabricksOVHProvider) Configure(ctx context.Context, req provider.Conf
 		)
 	}
 
+	if config.OVHClientID.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown OVH Client ID",
+			"The provider cannot create the OVH API client as there is an unknown configuration value for the OVH client ID.",
+		)
+	}
+
+	if config.OVHClientSecret.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown OVH Client Secret",
+			"The provider cannot create the OVH API client as there is an unknown configuration value for the OVH client secret.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	endpoint := config.OVHEndpoint.ValueString()
-	if endpoint == "" {
-		endpoint = os.Getenv("OVH_ENDPOINT")
+	maxRetries := transport.DefaultMaxRetries
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
 	}
 
-	applicationKey := config.OVHApplicationKey.ValueString()
-	if applicationKey == "" {
-		applicationKey = os.Getenv("OVH_APPLICATION_KEY")
+	retryMaxWait := transport.DefaultMaxWait
+	if v := config.RetryMaxWait.ValueString(); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid Retry Max Wait",
+				fmt.Sprintf("retry_max_wait must be a positive duration such as \"30s\" or \"2m\", got %q.", v),
+			)
+			return
+		}
+		retryMaxWait = d
 	}
 
-	applicationSecret := config.OVHApplicationSecret.ValueString()
-	if applicationSecret == "" {
-		applicationSecret = os.Getenv("OVH_APPLICATION_SECRET")
+	httpConfig, diags := resolveHTTPSettings(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	consumerKey := config.OVHConsumerKey.ValueString()
-	if consumerKey == "" {
-		consumerKey = os.Getenv("OVH_CONSUMER_KEY")
+	httpTransport, err := httpConfig.transport()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_bundle_file"),
+			"Invalid CA Bundle File",
+			"The provider could not load the certificate authorities of ca_bundle_file: "+err.Error(),
+		)
+		return
 	}
 
-	if endpoint == "" {
+	ovhConfigFiles, err := loadOVHConfig(ovhConfigPaths())
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"Missing OVH API Endpoint",
-			"The provider requires an OVH API endpoint to be configured.",
+			"Unable to Read OVH Configuration File",
+			"The provider could not read ovh.conf: "+err.Error(),
 		)
+		return
 	}
 
-	if applicationKey == "" {
-		resp.Diagnostics.AddError(
-			"Missing OVH Application Key",
-			"The provider requires an OVH application key to be configured.",
-		)
+	resolver := &settingResolver{files: ovhConfigFiles, sources: make(map[string]string)}
+
+	endpoint := resolver.resolve("ovh_endpoint", config.OVHEndpoint, "OVH_ENDPOINT", "default", "endpoint")
+	if endpoint == "" {
+		endpoint = defaultOVHEndpoint
+		resolver.sources["ovh_endpoint"] = "the default endpoint"
 	}
 
-	if applicationSecret == "" {
-		resp.Diagnostics.AddError(
-			"Missing OVH Application Secret",
-			"The provider requires an OVH application secret to be configured.",
+	endpointURL, err := resolveOVHEndpoint(endpoint)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ovh_endpoint"),
+			"Invalid OVH API Endpoint",
+			fmt.Sprintf("The OVH API endpoint, read from %s, is invalid: %s.", resolver.sources["ovh_endpoint"], err),
 		)
+		return
 	}
 
-	if consumerKey == "" {
-		resp.Diagnostics.AddError(
-			"Missing OVH Consumer Key",
-			"The provider requires an OVH consumer key to be configured.",
-		)
+	ovhCredentials := resolveOVHCredentials(resolver, config, endpoint)
+	resp.Diagnostics.Append(ovhCredentials.validate()...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := config.OVHProjectID.ValueString()
+	if projectID == "" {
+		projectID = os.Getenv("OVH_CLOUD_PROJECT_SERVICE")
+	}
+
+	databricksCredentials := databricks.Credentials{
+		Token:        config.DatabricksToken.ValueString(),
+		ClientID:     config.DatabricksClientID.ValueString(),
+		ClientSecret: config.DatabricksClientSecret.ValueString(),
+		Username:     config.DatabricksUsername.ValueString(),
+		Password:     config.DatabricksPassword.ValueString(),
+	}
+	if databricksCredentials.Token == "" {
+		databricksCredentials.Token = os.Getenv("DATABRICKS_TOKEN")
+	}
+	if databricksCredentials.ClientID == "" {
+		databricksCredentials.ClientID = os.Getenv("DATABRICKS_CLIENT_ID")
+	}
+	if databricksCredentials.ClientSecret == "" {
+		databricksCredentials.ClientSecret = os.Getenv("DATABRICKS_CLIENT_SECRET")
+	}
+	if databricksCredentials.Username == "" {
+		databricksCredentials.Username = os.Getenv("DATABRICKS_USERNAME")
+	}
+	if databricksCredentials.Password == "" {
+		databricksCredentials.Password = os.Getenv("DATABRICKS_PASSWORD")
 	}
 
+	resp.Diagnostics.Append(validateDatabricksCredentials(databricksCredentials)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	databricksAccountID := config.DatabricksAccountID.ValueString()
+	if databricksAccountID == "" {
+		databricksAccountID = os.Getenv("DATABRICKS_ACCOUNT_ID")
+	}
+
 	ctx = tflog.SetField(ctx, "ovh_endpoint", endpoint)
-	ctx = tflog.SetField(ctx, "ovh_application_key", applicationKey)
+	ctx = tflog.SetField(ctx, "ovh_project_id", projectID)
+	ctx = tflog.SetField(ctx, "ovh_application_key", ovhCredentials.ApplicationKey)
+	ctx = tflog.SetField(ctx, "ovh_client_id", ovhCredentials.ClientID)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "ovh_application_secret")
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "ovh_consumer_key")
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "ovh_client_secret")
+
+	sources := make(map[string]any, len(resolver.sources))
+	for attribute, source := range resolver.sources {
+		sources[attribute+"_source"] = source
+	}
+	tflog.Info(ctx, "Resolved OVH credentials", sources)
 
 	tflog.Debug(ctx, "Creating OVH client")
 
-	client, err := ovh.NewClient(
-		endpoint,
-		applicationKey,
-		applicationSecret,
-		consumerKey,
-	)
+	ovhClient, err := ovhCredentials.newClient(endpointURL)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create OVH API Client",
 			"An unexpected error occurred when creating the OVH API client. "+
 				"If the error is not clear, please contact the provider developers.\n\n"+
-				"OVH Client Error: "+err.Error(),
+				"OVH Client Error: "+err.Error()+"\n\n"+resolver.describe(),
 		)
 		return
 	}
 
+	// Each attempt is logged, below the retries.
+	ovhClient.Client.Transport = transport.NewRetry(
+		transport.NewLogging(httpTransport, "ovh"), maxRetries, retryMaxWait,
+	)
+	ovhClient.Timeout = httpConfig.Timeout
+
+	api := client.New(ovhClient)
+
+	switch {
+	case config.SkipCredentialsValidation.ValueBool():
+		tflog.Debug(ctx, "Skipping OVH credentials validation")
+	case ovhCredentials.usesOAuth2():
+		tflog.Debug(ctx, "Skipping OVH credentials validation of the OAuth2 service account")
+	default:
+		tflog.Debug(ctx, "Validating OVH credentials")
+
+		resp.Diagnostics.Append(validateOVHCredential(ctx, api, projectID, time.Now())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	providerConfig := &Config{
-		OVHClient: client,
+		OVHClient: ovhClient,
+		API:       api,
+		ProjectID: projectID,
+
+		DatabricksCredentials: databricksCredentials,
+		DatabricksAccountID:   databricksAccountID,
+		DatabricksHTTPClient: &http.Client{
+			Transport: transport.NewRetry(transport.NewLogging(httpTransport, "databricks"), maxRetries, retryMaxWait),
+			Timeout:   httpConfig.Timeout,
+		},
 	}
 
 	resp.DataSourceData = providerConfig
@@ -218,9 +551,14 @@ func (p *DatabricksOVHProvider) Configure(ctx context.Context, req provider.Conf
 func (p *DatabricksOVHProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewDatabricksWorkspaceResource,
+		NewDatabricksClusterResource,
 		NewDatabricksJobResource,
+		NewDatabricksJobRunResource,
 		NewDatabricksNotebookResource,
 		NewDatabricksSecretScopeResource,
+		NewDatabricksSecretResource,
+		NewDatabricksSecretACLResource,
+		NewDatabricksPermissionsResource,
 		NewDatabricksInstancePoolResource,
 		NewDatabricksClusterPolicyResource,
 	}
@@ -229,6 +567,7 @@ func (p *DatabricksOVHProvider) Resources(ctx context.Context) []func() resource
 func (p *DatabricksOVHProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewDatabricksWorkspacesDataSource,
+		NewDatabricksPolicyFamiliesDataSource,
 	}
 }
 
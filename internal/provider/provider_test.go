@@ -1,9 +1,12 @@
 package provider
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -14,3 +17,45 @@ func TestAccProvider(t *testing.T) {
 }
 
 var testAccProtoV6ProviderFactories map[string]func() (tfprotov6.ProviderServer, error)
+
+func TestResourceSchemas(t *testing.T) {
+	ctx := context.Background()
+	p := &DatabricksOVHProvider{}
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "databricks-ovh"}, &metadata)
+
+		var resp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: schema: %v", metadata.TypeName, resp.Diagnostics)
+		}
+		if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+			t.Fatalf("%s: invalid schema: %v", metadata.TypeName, diags)
+		}
+	}
+}
+
+func TestDataSourceSchemas(t *testing.T) {
+	ctx := context.Background()
+	p := &DatabricksOVHProvider{}
+
+	for _, newDataSource := range p.DataSources(ctx) {
+		d := newDataSource()
+
+		var metadata datasource.MetadataResponse
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "databricks-ovh"}, &metadata)
+
+		var resp datasource.SchemaResponse
+		d.Schema(ctx, datasource.SchemaRequest{}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: schema: %v", metadata.TypeName, resp.Diagnostics)
+		}
+		if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+			t.Fatalf("%s: invalid schema: %v", metadata.TypeName, diags)
+		}
+	}
+}
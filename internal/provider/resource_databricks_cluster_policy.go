@@ -173,7 +173,7 @@ func resourceDatabricksClusterPolicyCreate(ctx context.Context, d *schema.Resour
 	}
 
 	var result map[string]interface{}
-	err := config.OVHClient.Post("/cloud/project/databricks/cluster-policy", policyConfig, &result)
+	err := config.OVHClient.PostWithContext(ctx, "/cloud/project/databricks/cluster-policy", policyConfig, &result)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to create Databricks cluster policy: %w", err))
 	}
@@ -191,7 +191,7 @@ func resourceDatabricksClusterPolicyRead(ctx context.Context, d *schema.Resource
 	policyId := d.Id()
 
 	var policy map[string]interface{}
-	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/databricks/cluster-policy/%s", policyId), &policy)
+	err := config.OVHClient.GetWithContext(ctx, fmt.Sprintf("/cloud/project/databricks/cluster-policy/%s", policyId), &policy)
 	if err != nil {
 		d.SetId("")
 		return diag.FromErr(fmt.Errorf("failed to read Databricks cluster policy: %w", err))
@@ -238,7 +238,7 @@ func resourceDatabricksClusterPolicyUpdate(ctx context.Context, d *schema.Resour
 			updateConfig["libraries"] = d.Get("libraries").([]interface{})
 		}
 
-		err := config.OVHClient.Put(fmt.Sprintf("/cloud/project/databricks/cluster-policy/%s", policyId), updateConfig, nil)
+		err := config.OVHClient.PutWithContext(ctx, fmt.Sprintf("/cloud/project/databricks/cluster-policy/%s", policyId), updateConfig, nil)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("failed to update Databricks cluster policy: %w", err))
 		}
@@ -253,7 +253,7 @@ func resourceDatabricksClusterPolicyDelete(ctx context.Context, d *schema.Resour
 
 	policyId := d.Id()
 
-	err := config.OVHClient.Delete(fmt.Sprintf("/cloud/project/databricks/cluster-policy/%s", policyId), nil)
+	err := config.OVHClient.DeleteWithContext(ctx, fmt.Sprintf("/cloud/project/databricks/cluster-policy/%s", policyId), nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to delete Databricks cluster policy: %w", err))
 	}
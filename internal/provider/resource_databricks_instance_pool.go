@@ -201,7 +201,7 @@ func resourceDatabricksInstancePoolCreate(ctx context.Context, d *schema.Resourc
 	}
 
 	var result map[string]interface{}
-	err := config.OVHClient.Post("/cloud/project/databricks/instance-pool", poolConfig, &result)
+	err := config.OVHClient.PostWithContext(ctx, "/cloud/project/databricks/instance-pool", poolConfig, &result)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to create Databricks instance pool: %w", err))
 	}
@@ -219,7 +219,7 @@ func resourceDatabricksInstancePoolRead(ctx context.Context, d *schema.ResourceD
 	poolId := d.Id()
 
 	var pool map[string]interface{}
-	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/databricks/instance-pool/%s", poolId), &pool)
+	err := config.OVHClient.GetWithContext(ctx, fmt.Sprintf("/cloud/project/databricks/instance-pool/%s", poolId), &pool)
 	if err != nil {
 		d.SetId("")
 		return diag.FromErr(fmt.Errorf("failed to read Databricks instance pool: %w", err))
@@ -273,7 +273,7 @@ func resourceDatabricksInstancePoolUpdate(ctx context.Context, d *schema.Resourc
 			updateConfig["customTags"] = d.Get("custom_tags")
 		}
 
-		err := config.OVHClient.Put(fmt.Sprintf("/cloud/project/databricks/instance-pool/%s", poolId), updateConfig, nil)
+		err := config.OVHClient.PutWithContext(ctx, fmt.Sprintf("/cloud/project/databricks/instance-pool/%s", poolId), updateConfig, nil)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("failed to update Databricks instance pool: %w", err))
 		}
@@ -288,7 +288,7 @@ func resourceDatabricksInstancePoolDelete(ctx context.Context, d *schema.Resourc
 
 	poolId := d.Id()
 
-	err := config.OVHClient.Delete(fmt.Sprintf("/cloud/project/databricks/instance-pool/%s", poolId), nil)
+	err := config.OVHClient.DeleteWithContext(ctx, fmt.Sprintf("/cloud/project/databricks/instance-pool/%s", poolId), nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to delete Databricks instance pool: %w", err))
 	}
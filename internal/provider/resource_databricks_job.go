@@ -442,7 +442,7 @@ func resourceDatabricksJobCreate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	var result map[string]interface{}
-	err := config.OVHClient.Post("/cloud/project/databricks/job", jobConfig, &result)
+	err := config.OVHClient.PostWithContext(ctx, "/cloud/project/databricks/job", jobConfig, &result)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to create Databricks job: %w", err))
 	}
@@ -460,7 +460,7 @@ func resourceDatabricksJobRead(ctx context.Context, d *schema.ResourceData, meta
 	jobId := d.Id()
 
 	var job map[string]interface{}
-	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/databricks/job/%s", jobId), &job)
+	err := config.OVHClient.GetWithContext(ctx, fmt.Sprintf("/cloud/project/databricks/job/%s", jobId), &job)
 	if err != nil {
 		d.SetId("")
 		return diag.FromErr(fmt.Errorf("failed to read Databricks job: %w", err))
@@ -558,7 +558,7 @@ func resourceDatabricksJobUpdate(ctx context.Context, d *schema.ResourceData, me
 			updateConfig["tags"] = d.Get("tags")
 		}
 
-		err := config.OVHClient.Put(fmt.Sprintf("/cloud/project/databricks/job/%s", jobId), updateConfig, nil)
+		err := config.OVHClient.PutWithContext(ctx, fmt.Sprintf("/cloud/project/databricks/job/%s", jobId), updateConfig, nil)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("failed to update Databricks job: %w", err))
 		}
@@ -573,7 +573,7 @@ func resourceDatabricksJobDelete(ctx context.Context, d *schema.ResourceData, me
 
 	jobId := d.Id()
 
-	err := config.OVHClient.Delete(fmt.Sprintf("/cloud/project/databricks/job/%s", jobId), nil)
+	err := config.OVHClient.DeleteWithContext(ctx, fmt.Sprintf("/cloud/project/databricks/job/%s", jobId), nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to delete Databricks job: %w", err))
 	}
@@ -108,7 +108,7 @@ func resourceDatabricksNotebookCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	var result map[string]interface{}
-	err := config.OVHClient.Post("/cloud/project/databricks/notebook", notebookConfig, &result)
+	err := config.OVHClient.PostWithContext(ctx, "/cloud/project/databricks/notebook", notebookConfig, &result)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to create Databricks notebook: %w", err))
 	}
@@ -126,7 +126,7 @@ func resourceDatabricksNotebookRead(ctx context.Context, d *schema.ResourceData,
 	notebookPath := d.Id()
 
 	var notebook map[string]interface{}
-	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/databricks/notebook?path=%s", notebookPath), &notebook)
+	err := config.OVHClient.GetWithContext(ctx, fmt.Sprintf("/cloud/project/databricks/notebook?path=%s", notebookPath), &notebook)
 	if err != nil {
 		d.SetId("")
 		return diag.FromErr(fmt.Errorf("failed to read Databricks notebook: %w", err))
@@ -167,7 +167,7 @@ func resourceDatabricksNotebookUpdate(ctx context.Context, d *schema.ResourceDat
 			updateConfig["overwrite"] = d.Get("overwrite").(bool)
 		}
 
-		err := config.OVHClient.Put(fmt.Sprintf("/cloud/project/databricks/notebook"), updateConfig, nil)
+		err := config.OVHClient.PutWithContext(ctx, fmt.Sprintf("/cloud/project/databricks/notebook"), updateConfig, nil)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("failed to update Databricks notebook: %w", err))
 		}
@@ -187,7 +187,7 @@ func resourceDatabricksNotebookDelete(ctx context.Context, d *schema.ResourceDat
 		"recursive": false,
 	}
 
-	err := config.OVHClient.Post("/cloud/project/databricks/notebook/delete", deleteConfig, nil)
+	err := config.OVHClient.PostWithContext(ctx, "/cloud/project/databricks/notebook/delete", deleteConfig, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to delete Databricks notebook: %w", err))
 	}
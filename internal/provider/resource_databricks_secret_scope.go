@@ -80,7 +80,7 @@ func resourceDatabricksSecretScopeCreate(ctx context.Context, d *schema.Resource
 	}
 
 	var result map[string]interface{}
-	err := config.OVHClient.Post("/cloud/project/databricks/secret-scope", scopeConfig, &result)
+	err := config.OVHClient.PostWithContext(ctx, "/cloud/project/databricks/secret-scope", scopeConfig, &result)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to create Databricks secret scope: %w", err))
 	}
@@ -98,7 +98,7 @@ func resourceDatabricksSecretScopeRead(ctx context.Context, d *schema.ResourceDa
 	scopeName := d.Id()
 
 	var scope map[string]interface{}
-	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/databricks/secret-scope/%s", scopeName), &scope)
+	err := config.OVHClient.GetWithContext(ctx, fmt.Sprintf("/cloud/project/databricks/secret-scope/%s", scopeName), &scope)
 	if err != nil {
 		d.SetId("")
 		return diag.FromErr(fmt.Errorf("failed to read Databricks secret scope: %w", err))
@@ -121,7 +121,7 @@ func resourceDatabricksSecretScopeDelete(ctx context.Context, d *schema.Resource
 
 	scopeName := d.Id()
 
-	err := config.OVHClient.Delete(fmt.Sprintf("/cloud/project/databricks/secret-scope/%s", scopeName), nil)
+	err := config.OVHClient.DeleteWithContext(ctx, fmt.Sprintf("/cloud/project/databricks/secret-scope/%s", scopeName), nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to delete Databricks secret scope: %w", err))
 	}
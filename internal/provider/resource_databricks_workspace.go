@@ -149,7 +149,7 @@ func resourceDatabricksWorkspaceCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	var result map[string]interface{}
-	err := config.OVHClient.Post("/cloud/project/databricks/workspace", workspaceConfig, &result)
+	err := config.OVHClient.PostWithContext(ctx, "/cloud/project/databricks/workspace", workspaceConfig, &result)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to create Databricks workspace: %w", err))
 	}
@@ -167,7 +167,7 @@ func resourceDatabricksWorkspaceRead(ctx context.Context, d *schema.ResourceData
 	workspaceId := d.Id()
 
 	var workspace map[string]interface{}
-	err := config.OVHClient.Get(fmt.Sprintf("/cloud/project/databricks/workspace/%s", workspaceId), &workspace)
+	err := config.OVHClient.GetWithContext(ctx, fmt.Sprintf("/cloud/project/databricks/workspace/%s", workspaceId), &workspace)
 	if err != nil {
 		d.SetId("")
 		return diag.FromErr(fmt.Errorf("failed to read Databricks workspace: %w", err))
@@ -219,7 +219,7 @@ func resourceDatabricksWorkspaceUpdate(ctx context.Context, d *schema.ResourceDa
 			updateConfig["customTags"] = d.Get("custom_tags")
 		}
 
-		err := config.OVHClient.Put(fmt.Sprintf("/cloud/project/databricks/workspace/%s", workspaceId), updateConfig, nil)
+		err := config.OVHClient.PutWithContext(ctx, fmt.Sprintf("/cloud/project/databricks/workspace/%s", workspaceId), updateConfig, nil)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("failed to update Databricks workspace: %w", err))
 		}
@@ -234,7 +234,7 @@ func resourceDatabricksWorkspaceDelete(ctx context.Context, d *schema.ResourceDa
 
 	workspaceId := d.Id()
 
-	err := config.OVHClient.Delete(fmt.Sprintf("/cloud/project/databricks/workspace/%s", workspaceId), nil)
+	err := config.OVHClient.DeleteWithContext(ctx, fmt.Sprintf("/cloud/project/databricks/workspace/%s", workspaceId), nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to delete Databricks workspace: %w", err))
 	}
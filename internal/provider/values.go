@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringValueOrNull returns a null string for "", which the API uses for
+// fields that are not set.
+func stringValueOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// listToStrings converts a list of strings. Null and unknown lists give nil.
+func listToStrings(ctx context.Context, l types.List, diags *diag.Diagnostics) []string {
+	if l.IsNull() || l.IsUnknown() {
+		return nil
+	}
+
+	var out []string
+	diags.Append(l.ElementsAs(ctx, &out, false)...)
+	return out
+}
+
+// stringsToList converts a slice of strings into a list, null when empty.
+func stringsToList(ctx context.Context, s []string, diags *diag.Diagnostics) types.List {
+	if len(s) == 0 {
+		return types.ListNull(types.StringType)
+	}
+
+	l, d := types.ListValueFrom(ctx, types.StringType, s)
+	diags.Append(d...)
+	return l
+}
+
+// mapToStrings converts a map of strings. Null and unknown maps give nil.
+func mapToStrings(ctx context.Context, m types.Map, diags *diag.Diagnostics) map[string]string {
+	if m.IsNull() || m.IsUnknown() {
+		return nil
+	}
+
+	var out map[string]string
+	diags.Append(m.ElementsAs(ctx, &out, false)...)
+	return out
+}
+
+// stringsToMap converts a map of strings into a map value, null when empty.
+func stringsToMap(ctx context.Context, s map[string]string, diags *diag.Diagnostics) types.Map {
+	if len(s) == 0 {
+		return types.MapNull(types.StringType)
+	}
+
+	m, d := types.MapValueFrom(ctx, types.StringType, s)
+	diags.Append(d...)
+	return m
+}
+
+// refreshStringMap converts the entries of s whose keys are in prior, so that
+// entries the server adds on its own, such as the tags of a cluster policy,
+// are not reported as changes. A null prior map stays null; imported
+// resources start from the whole map instead, see readingImport.
+func refreshStringMap(ctx context.Context, prior types.Map, s map[string]string, diags *diag.Diagnostics) types.Map {
+	if prior.IsNull() {
+		return prior
+	}
+
+	out := map[string]string{}
+	for key := range prior.Elements() {
+		if v, ok := s[key]; ok {
+			out[key] = v
+		}
+	}
+
+	m, d := types.MapValueFrom(ctx, types.StringType, out)
+	diags.Append(d...)
+	return m
+}
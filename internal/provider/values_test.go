@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRefreshStringMap(t *testing.T) {
+	ctx := context.Background()
+	remote := map[string]string{"team": "data", "policy": "added-by-policy"}
+
+	tests := map[string]struct {
+		prior types.Map
+		want  types.Map
+	}{
+		"null": {
+			prior: types.MapNull(types.StringType),
+			want:  types.MapNull(types.StringType),
+		},
+		"empty": {
+			prior: types.MapValueMust(types.StringType, map[string]attr.Value{}),
+			want:  types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		},
+		"configured keys": {
+			prior: types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("ml")}),
+			want:  types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("data")}),
+		},
+		"removed remotely": {
+			prior: types.MapValueMust(types.StringType, map[string]attr.Value{"owner": types.StringValue("me")}),
+			want:  types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		},
+	}
+
+	for name, tt := range tests {
+		var diags diag.Diagnostics
+		got := refreshStringMap(ctx, tt.prior, remote, &diags)
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %v", name, diags)
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("%s: got %s, want %s", name, got, tt.want)
+		}
+	}
+}
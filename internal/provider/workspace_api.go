@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/client"
+	"github.com/swcstudio/terraform-provider-databricks-ovh/internal/databricks"
+)
+
+// Workspace objects can be managed either through the OVH API of a project or
+// through the REST API of the workspace itself, selected per resource with
+// workspace_url. The interfaces below are what each resource needs from
+// either client.
+
+type notebookAPI interface {
+	CreateNotebook(ctx context.Context, req *client.NotebookCreateRequest) (*client.Notebook, error)
+	GetNotebook(ctx context.Context, id string) (*client.Notebook, error)
+	UpdateNotebook(ctx context.Context, id string, req *client.NotebookUpdateRequest) error
+	DeleteNotebook(ctx context.Context, id string) error
+}
+
+type secretAPI interface {
+	PutSecret(ctx context.Context, scope string, req *client.SecretPutRequest) error
+	GetSecret(ctx context.Context, scope, key string) (*client.SecretMetadata, error)
+	DeleteSecret(ctx context.Context, scope, key string) error
+}
+
+type secretACLAPI interface {
+	PutSecretACL(ctx context.Context, scope string, acl *client.SecretACL) error
+	GetSecretACL(ctx context.Context, scope, principal string) (*client.SecretACL, error)
+	DeleteSecretACL(ctx context.Context, scope, principal string) error
+}
+
+type clusterAPI interface {
+	CreateCluster(ctx context.Context, req *client.ClusterCreateRequest) (*client.Cluster, error)
+	GetCluster(ctx context.Context, id string) (*client.Cluster, error)
+	UpdateCluster(ctx context.Context, id string, req *client.ClusterUpdateRequest) error
+	ResizeCluster(ctx context.Context, id string, req *client.ClusterResizeRequest) error
+	DeleteCluster(ctx context.Context, id string) error
+	WaitForClusterRunning(ctx context.Context, id string) (*client.Cluster, error)
+	WaitForClusterTerminated(ctx context.Context, id string) error
+	clusterPolicyAPI
+}
+
+// clusterPolicyAPI looks up the cluster policy a cluster or job refers to.
+type clusterPolicyAPI interface {
+	FindClusterPolicy(ctx context.Context, policyID string) (*client.ClusterPolicy, error)
+}
+
+var (
+	_ notebookAPI  = (*client.ProjectClient)(nil)
+	_ notebookAPI  = (*databricks.Client)(nil)
+	_ secretAPI    = (*client.WorkspaceClient)(nil)
+	_ secretAPI    = (*databricks.Client)(nil)
+	_ secretACLAPI = (*client.WorkspaceClient)(nil)
+	_ secretACLAPI = (*databricks.Client)(nil)
+	_ clusterAPI   = (*client.ProjectClient)(nil)
+	_ clusterAPI   = (*databricks.Client)(nil)
+)
+
+// workspaceURLAttribute is the attribute that switches a resource to the REST
+// API of its workspace.
+func workspaceURLAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "URL of the workspace, usually the workspace_url of a databricks-ovh_workspace. When set, the object is managed through the Databricks REST API of the workspace, " +
+			"authenticated with the provider databricks_token, databricks_client_id and databricks_client_secret, or databricks_username and databricks_password, instead of the OVH API",
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// workspaceURLIsSet is a RequiresReplaceIf condition for the changes that
+// only the OVH API can apply in place.
+func workspaceURLIsSet(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	var workspaceURL types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("workspace_url"), &workspaceURL)...)
+	resp.RequiresReplace = !workspaceURL.IsNull()
+}
+
+// importStateWithWorkspaceURL imports an object managed through the REST API
+// of its workspace from an identifier starting with the workspace URL, such
+// as "https://adb-1234.ovh.net/ws-1/0123-456789-abcde". The rest of the
+// identifier is imported by workspaceImporter. Identifiers without a URL are
+// imported by importer, as objects managed through the OVH API.
+func importStateWithWorkspaceURL(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, importer, workspaceImporter func(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse)) {
+	scheme, rest, found := strings.Cut(req.ID, "://")
+	if !found {
+		importer(ctx, req, resp)
+		return
+	}
+
+	host, rest, _ := strings.Cut(rest, "/")
+	if scheme == "" || host == "" || rest == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <workspace_url>/<id>, such as https://adb-1234.ovh.net/ws-1/0123-456789-abcde. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_url"), scheme+"://"+host)...)
+	req.ID = rest
+	workspaceImporter(ctx, req, resp)
+}
+
+// importStateInWorkspace imports an object managed through the REST API of
+// its workspace from "<workspace_id>/<id>", the workspace API not returning
+// the workspace of its objects.
+func importStateInWorkspace(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspaceID, id, _ := strings.Cut(req.ID, "/")
+	if workspaceID == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <workspace_url>/<workspace_id>/<id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), workspaceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// workspaceAPI returns a client for the REST API of the workspace at
+// workspaceURL. project_id is not needed by that API; it is only set to the
+// provider default when unknown so that it is known after apply.
+func (c *Config) workspaceAPI(workspaceURL types.String, projectID *types.String) (*databricks.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !c.DatabricksCredentials.Valid() {
+		diags.AddAttributeError(
+			path.Root("workspace_url"),
+			"Missing Databricks Credentials",
+			"Managing an object through the workspace API requires databricks_token, databricks_client_id and databricks_client_secret, "+
+				"or databricks_username and databricks_password on the provider, or the matching DATABRICKS_* environment variables.",
+		)
+		return nil, diags
+	}
+
+	if projectID.IsUnknown() {
+		*projectID = stringValueOrNull(c.ProjectID)
+	}
+
+	return databricks.New(workspaceURL.ValueString(), c.workspaceCredentials(workspaceURL.ValueString()), c.DatabricksHTTPClient), diags
+}
+
+// projectAPI resolves the project of a resource managed through the OVH API
+// and stores it in projectID.
+func (c *Config) projectAPI(projectID *types.String) (*client.ProjectClient, diag.Diagnostics) {
+	id, diags := c.projectID(*projectID)
+	if diags.HasError() {
+		return nil, diags
+	}
+	*projectID = types.StringValue(id)
+
+	return c.API.Project(id), diags
+}
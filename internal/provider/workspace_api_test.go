@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestImportStateWithWorkspaceURL(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		resource resource.ResourceWithImportState
+		id       string
+		want     map[string]string
+	}{
+		"cluster through the OVH API": {
+			resource: &DatabricksClusterResource{},
+			id:       "project-1/cluster-1",
+			want:     map[string]string{"project_id": "project-1", "id": "cluster-1"},
+		},
+		"cluster through the workspace API": {
+			resource: &DatabricksClusterResource{},
+			id:       "https://adb-1234.ovh.net/ws-1/0123-456789-abcde",
+			want:     map[string]string{"workspace_url": "https://adb-1234.ovh.net", "workspace_id": "ws-1", "id": "0123-456789-abcde"},
+		},
+		"notebook through the workspace API": {
+			resource: &DatabricksNotebookResource{},
+			id:       "https://adb-1234.ovh.net/ws-1/Shared/etl",
+			want:     map[string]string{"workspace_url": "https://adb-1234.ovh.net", "workspace_id": "ws-1", "id": "/Shared/etl"},
+		},
+		"secret through the workspace API": {
+			resource: &DatabricksSecretResource{},
+			id:       "https://adb-1234.ovh.net/ws-1/etl/password",
+			want:     map[string]string{"workspace_url": "https://adb-1234.ovh.net", "workspace_id": "ws-1", "scope": "etl", "key": "password", "id": "ws-1/etl/password"},
+		},
+		"missing object": {
+			resource: &DatabricksClusterResource{},
+			id:       "https://adb-1234.ovh.net/ws-1",
+		},
+	}
+
+	for name, tt := range tests {
+		var schemaResp resource.SchemaResponse
+		tt.resource.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+		resp := resource.ImportStateResponse{
+			State: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			},
+		}
+		tt.resource.ImportState(ctx, resource.ImportStateRequest{ID: tt.id}, &resp)
+
+		if tt.want == nil {
+			if !resp.Diagnostics.HasError() {
+				t.Errorf("%s: expected an error", name)
+			}
+			continue
+		}
+		if resp.Diagnostics.HasError() {
+			t.Errorf("%s: unexpected error: %v", name, resp.Diagnostics)
+			continue
+		}
+		for attribute, want := range tt.want {
+			var got types.String
+			resp.State.GetAttribute(ctx, path.Root(attribute), &got)
+			if got.ValueString() != want {
+				t.Errorf("%s: got %s %q, want %q", name, attribute, got.ValueString(), want)
+			}
+		}
+	}
+}
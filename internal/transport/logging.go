@@ -0,0 +1,182 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// MaxLoggedBodySize is the number of bytes of a body that are logged.
+const MaxLoggedBodySize = 8 << 10
+
+// logLevelEnv, followed by the upper-cased subsystem, names the environment
+// variable that sets the level of a subsystem, such as
+// TF_LOG_PROVIDER_DATABRICKS_OVH_OVH=TRACE.
+const logLevelEnv = "TF_LOG_PROVIDER_DATABRICKS_OVH"
+
+// secretFields are the JSON and form fields whose values are never logged,
+// compared lower-cased and without separators.
+var secretFields = map[string]bool{
+	"accesstoken":       true,
+	"applicationsecret": true,
+	"bytesvalue":        true,
+	"clientsecret":      true,
+	"consumerkey":       true,
+	"content":           true,
+	"idtoken":           true,
+	"password":          true,
+	"refreshtoken":      true,
+	"secret":            true,
+	"stringvalue":       true,
+	"token":             true,
+	"tokenvalue":        true,
+}
+
+// Logging is an http.RoundTripper that logs each request to a tflog
+// subsystem: the method, URL, status and duration at DEBUG, and the bodies
+// at TRACE. The values of known secret fields are masked and bodies are
+// truncated to MaxLoggedBodySize.
+type Logging struct {
+	// Base is the transport sending the requests. Nil means
+	// http.DefaultTransport.
+	Base http.RoundTripper
+
+	// Subsystem is the name of the tflog subsystem, such as "ovh".
+	Subsystem string
+}
+
+// NewLogging returns a Logging around base that logs to subsystem.
+func NewLogging(base http.RoundTripper, subsystem string) *Logging {
+	return &Logging{Base: base, Subsystem: subsystem}
+}
+
+func (t *Logging) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	ctx := tflog.NewSubsystem(req.Context(), t.Subsystem, tflog.WithLevelFromEnv(logLevelEnv, t.Subsystem))
+	fields := map[string]any{
+		"http_method": req.Method,
+		"http_url":    req.URL.Redacted(),
+	}
+
+	reqFields := map[string]any{}
+	if req.Body != nil && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			b, _ := io.ReadAll(body)
+			body.Close()
+			reqFields["http_request_body"] = redactBody(req.Header.Get("Content-Type"), b)
+		}
+	}
+	tflog.SubsystemTrace(ctx, t.Subsystem, "sending HTTP request", fields, reqFields)
+
+	start := time.Now()
+	resp, err := base.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		tflog.SubsystemDebug(ctx, t.Subsystem, "HTTP request failed", fields, map[string]any{"error": err.Error()})
+		return resp, err
+	}
+
+	fields["http_status"] = resp.StatusCode
+	if queryID := resp.Header.Get("X-Ovh-QueryID"); queryID != "" {
+		fields["ovh_query_id"] = queryID
+	}
+	tflog.SubsystemDebug(ctx, t.Subsystem, "received HTTP response", fields)
+
+	// The body is read to be logged and replaced by a copy for the caller.
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		tflog.SubsystemDebug(ctx, t.Subsystem, "reading HTTP response body failed", fields, map[string]any{"error": err.Error()})
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(b))
+	tflog.SubsystemTrace(ctx, t.Subsystem, "HTTP response body", fields, map[string]any{
+		"http_response_body": redactBody(resp.Header.Get("Content-Type"), b),
+	})
+
+	return resp, nil
+}
+
+// redactBody returns a body for the logs, with the values of the secret
+// fields of JSON and form bodies masked, truncated to MaxLoggedBodySize.
+// Other bodies that cannot be masked are not logged.
+func redactBody(contentType string, b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+
+	var body string
+	switch {
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		values, err := url.ParseQuery(string(b))
+		if err != nil {
+			return fmt.Sprintf("<%d bytes of invalid form data>", len(b))
+		}
+		for key := range values {
+			if isSecretField(key) {
+				values[key] = []string{"***"}
+			}
+		}
+		body = values.Encode()
+	default:
+		// Numbers are kept as is, as IDs may not fit in a float64.
+		decoder := json.NewDecoder(bytes.NewReader(b))
+		decoder.UseNumber()
+		var v any
+		if err := decoder.Decode(&v); err != nil {
+			return fmt.Sprintf("<%d bytes of %s>", len(b), contentTypeOrUnknown(contentType))
+		}
+		redacted, err := json.Marshal(redactJSON(v))
+		if err != nil {
+			return fmt.Sprintf("<%d bytes of JSON>", len(b))
+		}
+		body = string(redacted)
+	}
+
+	if len(body) > MaxLoggedBodySize {
+		return body[:MaxLoggedBodySize] + fmt.Sprintf("... (truncated, %d bytes)", len(body))
+	}
+	return body
+}
+
+func redactJSON(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if isSecretField(key) && value != nil {
+				v[key] = "***"
+			} else {
+				v[key] = redactJSON(value)
+			}
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = redactJSON(value)
+		}
+	}
+	return v
+}
+
+func isSecretField(key string) bool {
+	key = strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+	return secretFields[key]
+}
+
+func contentTypeOrUnknown(contentType string) string {
+	if contentType == "" {
+		return "data"
+	}
+	return contentType
+}
@@ -0,0 +1,100 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Ovh-QueryID", "EU.ext-1.abc")
+		io.WriteString(w, `{"id":1234567890123456789,"token_value":"dapi-secret"}`)
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &logs)
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/secrets/put", strings.NewReader(`{"scope":"etl","string_value":"hunter2"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := NewLogging(nil, "ovh").RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "dapi-secret") {
+		t.Errorf("expected the caller to get the whole body, got %s", body)
+	}
+
+	out := logs.String()
+	if strings.Contains(out, "hunter2") || strings.Contains(out, "dapi-secret") {
+		t.Errorf("secrets were logged: %s", out)
+	}
+	if !strings.Contains(out, "1234567890123456789") {
+		t.Errorf("expected the response body to be logged with its numbers as is: %s", out)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&logs)
+	if err != nil {
+		t.Fatalf("decoding logs: %s", err)
+	}
+
+	var response map[string]any
+	for _, entry := range entries {
+		if entry["@module"] != "provider.ovh" {
+			t.Errorf("unexpected module in %v", entry)
+		}
+		if entry["@message"] == "received HTTP response" {
+			response = entry
+		}
+	}
+	if response == nil || response["http_status"] != float64(200) || response["ovh_query_id"] != "EU.ext-1.abc" {
+		t.Errorf("unexpected response entry %v", response)
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := map[string]struct {
+		contentType string
+		body        string
+		want        string
+	}{
+		"json": {
+			contentType: "application/json",
+			body:        `{"consumerKey":"ck","items":[{"content":"cHJpbnQ=","path":"/a"}]}`,
+			want:        `{"consumerKey":"***","items":[{"content":"***","path":"/a"}]}`,
+		},
+		"form": {
+			contentType: "application/x-www-form-urlencoded",
+			body:        "client_secret=s&grant_type=client_credentials",
+			want:        "client_secret=%2A%2A%2A&grant_type=client_credentials",
+		},
+		"not json": {
+			contentType: "text/html",
+			body:        "<html>token=abc</html>",
+			want:        "<22 bytes of text/html>",
+		},
+		"truncated": {
+			contentType: "application/json",
+			body:        `"` + strings.Repeat("a", MaxLoggedBodySize) + `"`,
+			want:        `"` + strings.Repeat("a", MaxLoggedBodySize-1) + "... (truncated, 8194 bytes)",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := redactBody(tt.contentType, []byte(tt.body)); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Package transport implements the http.RoundTrippers shared by the OVH and
+// Databricks API clients.
+package transport
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Default retry settings.
+const (
+	DefaultMaxRetries = 5
+	DefaultMinWait    = time.Second
+	DefaultMaxWait    = 30 * time.Second
+)
+
+// Retry is an http.RoundTripper that retries throttled requests and transient
+// errors with exponential backoff and jitter.
+//
+// Idempotent requests (GET, HEAD, OPTIONS, PUT, DELETE and requests with an
+// Idempotency-Key header) are retried on network errors and on 429, 502, 503
+// and 504 responses. Other POST requests are only retried on 429, which the
+// API returns before processing them.
+type Retry struct {
+	// Base is the transport sending the requests. Nil means
+	// http.DefaultTransport.
+	Base http.RoundTripper
+
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+
+	// MinWait is the delay before the first retry; it doubles with each
+	// retry up to MaxWait. A Retry-After longer than MaxWait is not waited
+	// for and the response is returned as is.
+	MinWait time.Duration
+	MaxWait time.Duration
+}
+
+// NewRetry returns a Retry around base with the given settings.
+func NewRetry(base http.RoundTripper, maxRetries int, maxWait time.Duration) *Retry {
+	minWait := DefaultMinWait
+	if minWait > maxWait {
+		minWait = maxWait
+	}
+	return &Retry{Base: base, MaxRetries: maxRetries, MinWait: minWait, MaxWait: maxWait}
+}
+
+func (t *Retry) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	for attempt := 0; ; attempt++ {
+		// A RoundTripper must not modify the request, so retries send a
+		// copy with a fresh body.
+		r := req
+		if attempt > 0 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r = req.Clone(req.Context())
+			r.Body = body
+		}
+
+		resp, err := base.RoundTrip(r)
+
+		if attempt >= t.MaxRetries || !t.retryable(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt)
+		if resp != nil {
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+				if retryAfter > t.MaxWait {
+					return resp, err
+				}
+				wait = retryAfter
+			}
+
+			// Drain the body so that the connection can be reused.
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryable reports whether the request may be sent again after the given
+// outcome.
+func (t *Retry) retryable(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if req.Body != nil && req.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		return idempotent(req)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent(req)
+	default:
+		return false
+	}
+}
+
+// backoff returns the delay before the given retry: an exponentially growing
+// delay capped by MaxWait, of which a random half is waited.
+func (t *Retry) backoff(attempt int) time.Duration {
+	wait := t.MaxWait
+	if attempt < 32 && t.MinWait<<attempt < t.MaxWait {
+		wait = t.MinWait << attempt
+	}
+	if wait <= 1 {
+		return wait
+	}
+	return wait/2 + rand.N(wait/2)
+}
+
+// idempotent reports whether sending the request twice has the same effect as
+// sending it once, like net/http does.
+func idempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	_, hasKey := req.Header["Idempotency-Key"]
+	return hasKey
+}
+
+// parseRetryAfter parses a Retry-After header, in seconds or as an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(v); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
@@ -0,0 +1,132 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// flakyServer fails the first failures requests with status and the given
+// headers, then answers 200. It returns the number of requests received.
+func flakyServer(t *testing.T, failures int32, status int, header http.Header) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if b, err := io.ReadAll(r.Body); err == nil && r.Method == http.MethodPost && string(b) != `{"a":1}` {
+			t.Errorf("unexpected body %q on attempt %d", b, requests.Load()+1)
+		}
+		if requests.Add(1) <= failures {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func newTestRetry() *Retry {
+	return &Retry{MaxRetries: 3, MinWait: time.Millisecond, MaxWait: 10 * time.Millisecond}
+}
+
+func TestRetry(t *testing.T) {
+	tests := map[string]struct {
+		method       string
+		failures     int32
+		status       int
+		header       http.Header
+		wantStatus   int
+		wantRequests int32
+	}{
+		"get recovers from 503":           {method: http.MethodGet, failures: 2, status: 503, wantStatus: 200, wantRequests: 3},
+		"delete recovers from 502":        {method: http.MethodDelete, failures: 1, status: 502, wantStatus: 200, wantRequests: 2},
+		"post recovers from 429":          {method: http.MethodPost, failures: 2, status: 429, wantStatus: 200, wantRequests: 3},
+		"post is not retried on 503":      {method: http.MethodPost, failures: 1, status: 503, wantStatus: 503, wantRequests: 1},
+		"client errors are not retried":   {method: http.MethodGet, failures: 1, status: 400, wantStatus: 400, wantRequests: 1},
+		"gives up after max retries":      {method: http.MethodGet, failures: 10, status: 503, wantStatus: 503, wantRequests: 4},
+		"long retry-after is not awaited": {method: http.MethodGet, failures: 1, status: 429, header: http.Header{"Retry-After": {"60"}}, wantStatus: 429, wantRequests: 1},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server, requests := flakyServer(t, tt.failures, tt.status, tt.header)
+			c := &http.Client{Transport: newTestRetry()}
+
+			req, err := http.NewRequest(tt.method, server.URL, strings.NewReader(`{"a":1}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := c.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("got status %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if got := requests.Load(); got != tt.wantRequests {
+				t.Errorf("got %d requests, want %d", got, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestRetryHonoursRetryAfter(t *testing.T) {
+	server, requests := flakyServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": {"1"}})
+	c := &http.Client{Transport: &Retry{MaxRetries: 3, MinWait: time.Millisecond, MaxWait: 2 * time.Second}}
+
+	start := time.Now()
+	resp, err := c.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %s, before the Retry-After delay", elapsed)
+	}
+	if resp.StatusCode != http.StatusOK || requests.Load() != 2 {
+		t.Errorf("got status %d after %d requests", resp.StatusCode, requests.Load())
+	}
+}
+
+func TestRetryStopsWhenContextIsDone(t *testing.T) {
+	server, requests := flakyServer(t, 10, http.StatusServiceUnavailable, nil)
+	c := &http.Client{Transport: &Retry{MaxRetries: 10, MinWait: time.Hour, MaxWait: time.Hour}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	_, err := c.Do(req)
+	if err == nil || ctx.Err() == nil {
+		t.Fatalf("expected the request to be cancelled, got %v", err)
+	}
+	if requests.Load() != 1 {
+		t.Errorf("got %d requests, want 1", requests.Load())
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	r := &Retry{MinWait: time.Second, MaxWait: 10 * time.Second}
+
+	for attempt, max := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second} {
+		for range 20 {
+			if got := r.backoff(attempt); got < max/2 || got > max {
+				t.Fatalf("attempt %d: got %s, want between %s and %s", attempt, got, max/2, max)
+			}
+		}
+	}
+}